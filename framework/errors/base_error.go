@@ -43,24 +43,23 @@ The error code should follow the 'xyyzzz' convention:
   - 'zzz' (last three digits): specific error detail.
 
 **Note:** The 'xyy' prefix of the code must match a valid category defined in `validCategories`.
+When strict mode is enabled (see `SetStrictCodes`), the code must also have been registered with `RegisterCode`.
 */
 func NewBaseError(code, message string, data interface{}) (*BaseError, error) {
-	// Validate the error code length
-	const codeLength = 6
-	if len(code) != codeLength {
-		return nil, fmt.Errorf("error creation failed: error code '%s' must be exactly %d characters", code, codeLength)
+	// Validate the error code format and category
+	if err := validateCode(code); err != nil {
+		return nil, fmt.Errorf("error creation failed: %w", err)
 	}
 
-	// Extract the category 'xyy' from the error code
-	xyy := code[:3]
-
-	// Validate the extracted category
-	if !IsValidCategory(xyy) {
-		return nil, fmt.Errorf("error creation failed: invalid category '%s' in code '%s'", xyy, code)
+	// In strict mode, the code must have been registered
+	if IsStrictCodes() {
+		if _, registered := DescribeCode(code); !registered {
+			return nil, fmt.Errorf("error creation failed: code '%s' is not registered", code)
+		}
 	}
 
-	// Determine the HTTP status code for the category
-	httpCode := GetCategoryHTTPStatus(xyy)
+	// Determine the HTTP status code for the category 'xyy'
+	httpCode := GetCategoryHTTPStatus(code[:3])
 
 	// Assign default message if no custom message is provided
 	if message == "" {
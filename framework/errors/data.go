@@ -0,0 +1,96 @@
+package errors
+
+import (
+	"math"
+	"reflect"
+)
+
+// DataString returns the string stored under key in the data of the DomainError found in the error chain.
+// It returns false if there is no DomainError, its data is not a map with string keys (e.g., map[string]interface{}
+// or map[string]string), the key is missing, or the value is not a string.
+//
+// Example:
+//
+//	err := errors.NewBadRequestError("invalid field", map[string]interface{}{"field": "email"})
+//	field, ok := errors.DataString(err, "field") // "email", true
+func DataString(err error, key string) (string, bool) {
+	value, ok := dataValue(err, key)
+	if !ok {
+		return "", false
+	}
+	s, ok := value.(string)
+	return s, ok
+}
+
+// DataInt returns the integer stored under key in the data of the DomainError found in the error chain.
+// Values of any integer type are accepted if they fit in an int; other types (including floats and numeric strings)
+// are not converted. It returns false under the same conditions as DataString.
+func DataInt(err error, key string) (int, bool) {
+	value, ok := dataValue(err, key)
+	if !ok || value == nil {
+		return 0, false
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := v.Int()
+		if n < math.MinInt || n > math.MaxInt {
+			return 0, false
+		}
+		return int(n), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n := v.Uint()
+		if n > math.MaxInt {
+			return 0, false
+		}
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
+
+// DataMap returns the nested map stored under key in the data of the DomainError found in the error chain.
+// Maps with string keys of other value types (e.g., map[string]string) are converted to a map[string]interface{}.
+// It returns false under the same conditions as DataString, or if the value is not such a map.
+func DataMap(err error, key string) (map[string]interface{}, bool) {
+	value, ok := dataValue(err, key)
+	if !ok {
+		return nil, false
+	}
+	return toStringKeyedMap(value)
+}
+
+// dataValue returns the value stored under key in the data of the DomainError found in the error chain,
+// if the data is a map with string keys.
+func dataValue(err error, key string) (interface{}, bool) {
+	domainErr := UnwrapDomainError(err)
+	if domainErr == nil {
+		return nil, false
+	}
+	data, ok := toStringKeyedMap(domainErr.GetData())
+	if !ok {
+		return nil, false
+	}
+	value, ok := data[key]
+	return value, ok
+}
+
+// toStringKeyedMap converts a map with string keys of any value type to a map[string]interface{}.
+func toStringKeyedMap(value interface{}) (map[string]interface{}, bool) {
+	if m, ok := value.(map[string]interface{}); ok {
+		return m, true
+	}
+	if value == nil {
+		return nil, false
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String || v.IsNil() {
+		return nil, false
+	}
+	m := make(map[string]interface{}, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		m[iter.Key().String()] = iter.Value().Interface()
+	}
+	return m, true
+}
@@ -0,0 +1,93 @@
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataGetters(t *testing.T) {
+	err := fmt.Errorf("create order: %w", domain_error.NewUnprocessableEntityError("invalid order", map[string]interface{}{
+		"field":    "quantity",
+		"max":      100,
+		"attempts": int64(3),
+		"ratio":    1.5,
+		"limit":    "10",
+		"details":  map[string]string{"reason": "out of stock"},
+		"nested":   map[string]interface{}{"sku": "A-1"},
+	}))
+
+	t.Run("DataString", func(t *testing.T) {
+		value, ok := domain_error.DataString(err, "field")
+		assert.True(t, ok)
+		assert.Equal(t, "quantity", value)
+
+		_, ok = domain_error.DataString(err, "missing")
+		assert.False(t, ok, "missing key")
+		_, ok = domain_error.DataString(err, "max")
+		assert.False(t, ok, "wrong type")
+	})
+
+	t.Run("DataInt", func(t *testing.T) {
+		value, ok := domain_error.DataInt(err, "max")
+		assert.True(t, ok)
+		assert.Equal(t, 100, value)
+
+		value, ok = domain_error.DataInt(err, "attempts")
+		assert.True(t, ok)
+		assert.Equal(t, 3, value)
+
+		_, ok = domain_error.DataInt(err, "missing")
+		assert.False(t, ok, "missing key")
+		_, ok = domain_error.DataInt(err, "limit")
+		assert.False(t, ok, "numeric string")
+		_, ok = domain_error.DataInt(err, "ratio")
+		assert.False(t, ok, "float")
+	})
+
+	t.Run("DataMap", func(t *testing.T) {
+		value, ok := domain_error.DataMap(err, "nested")
+		assert.True(t, ok)
+		assert.Equal(t, map[string]interface{}{"sku": "A-1"}, value)
+
+		value, ok = domain_error.DataMap(err, "details")
+		assert.True(t, ok)
+		assert.Equal(t, map[string]interface{}{"reason": "out of stock"}, value)
+
+		_, ok = domain_error.DataMap(err, "missing")
+		assert.False(t, ok, "missing key")
+		_, ok = domain_error.DataMap(err, "field")
+		assert.False(t, ok, "wrong type")
+	})
+}
+
+func TestDataGetters_DataTypes(t *testing.T) {
+	t.Run("map with non-interface values", func(t *testing.T) {
+		err := domain_error.NewBadRequestError("invalid field", map[string]string{"field": "email"})
+		value, ok := domain_error.DataString(err, "field")
+		assert.True(t, ok)
+		assert.Equal(t, "email", value)
+	})
+
+	t.Run("non-map data", func(t *testing.T) {
+		err := domain_error.NewBadRequestError("invalid field", []string{"email"})
+		_, ok := domain_error.DataString(err, "field")
+		assert.False(t, ok)
+	})
+
+	t.Run("nil data", func(t *testing.T) {
+		err := domain_error.NewBadRequestError("invalid field", nil)
+		_, ok := domain_error.DataInt(err, "field")
+		assert.False(t, ok)
+	})
+
+	t.Run("non-domain error", func(t *testing.T) {
+		_, ok := domain_error.DataMap(errors.New("plain error"), "field")
+		assert.False(t, ok)
+		_, ok = domain_error.DataString(nil, "field")
+		assert.False(t, ok)
+	})
+}
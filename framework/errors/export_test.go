@@ -0,0 +1,4 @@
+package errors
+
+// ResetCodeRegistry exposes resetCodeRegistry to the external test package.
+var ResetCodeRegistry = resetCodeRegistry
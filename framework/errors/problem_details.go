@@ -0,0 +1,95 @@
+package errors
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	// ProblemDetailsContentType is the media type of problem details responses (RFC 7807).
+	ProblemDetailsContentType = "application/problem+json"
+	// DefaultProblemType is the problem type used when no specific type is set, meaning that the problem
+	// has no additional semantics beyond the HTTP status code.
+	DefaultProblemType = "about:blank"
+)
+
+// ProblemDetails is an HTTP problem details object as defined by RFC 7807.
+// Extensions are serialized as additional top-level members; they cannot override the standard members.
+type ProblemDetails struct {
+	// Type is a URI reference identifying the problem type. Defaults to DefaultProblemType.
+	Type string `json:"type"`
+	// Title is a short, human-readable summary of the problem type (the HTTP status text).
+	Title string `json:"title"`
+	// Status is the HTTP status code.
+	Status int `json:"status"`
+	// Detail is a human-readable explanation specific to this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+	// Instance is a URI reference identifying this occurrence of the problem (e.g., the request path).
+	Instance string `json:"instance,omitempty"`
+	// Code is the full error code of the DomainError (e.g., 'SVC-402000').
+	Code string `json:"code,omitempty"`
+	// Extensions are additional members (e.g., the error data under "data").
+	Extensions map[string]interface{} `json:"-"`
+}
+
+// MarshalJSON serializes the problem details with the extensions as top-level members.
+func (p ProblemDetails) MarshalJSON() ([]byte, error) {
+	members := make(map[string]interface{}, len(p.Extensions)+6)
+	for key, value := range p.Extensions {
+		members[key] = value
+	}
+	members["type"] = p.Type
+	members["title"] = p.Title
+	members["status"] = p.Status
+	if p.Detail != "" {
+		members["detail"] = p.Detail
+	}
+	if p.Instance != "" {
+		members["instance"] = p.Instance
+	}
+	if p.Code != "" {
+		members["code"] = p.Code
+	}
+	return json.Marshal(members)
+}
+
+/*
+ToProblemDetails converts an error into problem details (RFC 7807) for the given instance (e.g., the request path).
+
+  - For an error with a DomainError in its chain, the status is the error's HTTP status, the title is the matching
+    status text, the detail is the error message, the code is the full error code, and the error data, if any,
+    is included under the "data" extension.
+  - For other errors (including nil), a generic 500 Internal Server Error without detail is returned,
+    so the error message is not exposed.
+
+Call Sanitize first to also hide the details of server errors.
+
+Example:
+
+	problem := errors.ToProblemDetails(errors.NewNotFoundError("user not found", nil), "/users/42")
+	// {"type":"about:blank","title":"Not Found","status":404,"detail":"user not found","instance":"/users/42","code":"ERR-402000"}
+*/
+func ToProblemDetails(err error, instance string) ProblemDetails {
+	domainErr := UnwrapDomainError(err)
+	if domainErr == nil {
+		return ProblemDetails{
+			Type:     DefaultProblemType,
+			Title:    http.StatusText(http.StatusInternalServerError),
+			Status:   http.StatusInternalServerError,
+			Instance: instance,
+		}
+	}
+
+	problem := ProblemDetails{
+		Type:     DefaultProblemType,
+		Title:    http.StatusText(domainErr.GetHTTPCode()),
+		Status:   domainErr.GetHTTPCode(),
+		Detail:   domainErr.GetMessage(),
+		Instance: instance,
+		Code:     domainErr.Code(),
+	}
+	if data := domainErr.GetData(); data != nil {
+		problem.Extensions = map[string]interface{}{"data": data}
+	}
+	return problem
+}
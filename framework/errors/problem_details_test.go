@@ -0,0 +1,69 @@
+package errors_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToProblemDetails_DomainError(t *testing.T) {
+	notFoundErr := domain_error.NewNotFoundError("user not found", map[string]string{"user_id": "42"})
+
+	problem := domain_error.ToProblemDetails(fmt.Errorf("get user: %w", notFoundErr), "/users/42")
+
+	assert.Equal(t, domain_error.DefaultProblemType, problem.Type)
+	assert.Equal(t, "Not Found", problem.Title)
+	assert.Equal(t, http.StatusNotFound, problem.Status)
+	assert.Equal(t, "user not found", problem.Detail)
+	assert.Equal(t, "/users/42", problem.Instance)
+	assert.Equal(t, domain_error.GetFullCode(domain_error.StatusCodeGenericNotFoundError), problem.Code)
+
+	serialized, err := json.Marshal(problem)
+	require.NoError(t, err)
+	assert.JSONEq(t, fmt.Sprintf(`{
+		"type": "about:blank",
+		"title": "Not Found",
+		"status": 404,
+		"detail": "user not found",
+		"instance": "/users/42",
+		"code": %q,
+		"data": {"user_id": "42"}
+	}`, problem.Code), string(serialized))
+}
+
+func TestToProblemDetails_NonDomainError(t *testing.T) {
+	for _, err := range []error{errors.New("dial tcp 10.0.0.5:5432: connection refused"), nil} {
+		problem := domain_error.ToProblemDetails(err, "")
+
+		serialized, marshalErr := json.Marshal(problem)
+		require.NoError(t, marshalErr)
+		assert.JSONEq(t, `{"type": "about:blank", "title": "Internal Server Error", "status": 500}`, string(serialized))
+	}
+}
+
+func TestProblemDetails_MarshalJSON(t *testing.T) {
+	problem := domain_error.ProblemDetails{
+		Type:   "https://example.com/problems/out-of-credit",
+		Title:  "You do not have enough credit.",
+		Status: http.StatusForbidden,
+		Extensions: map[string]interface{}{
+			"balance": 30,
+			"status":  200, // Standard members cannot be overridden.
+		},
+	}
+
+	serialized, err := json.Marshal(problem)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "https://example.com/problems/out-of-credit",
+		"title": "You do not have enough credit.",
+		"status": 403,
+		"balance": 30
+	}`, string(serialized))
+}
@@ -0,0 +1,55 @@
+package errors
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// Keys of the data map carried by errors created with RecoverToError and RecoverToErrorWithStack.
+const (
+	RecoveredPanicDataKey = "panic" // The recovered panic value, formatted with %v.
+	RecoveredStackDataKey = "stack" // The stack trace at the time of conversion (RecoverToErrorWithStack only).
+)
+
+// RecoverToError converts a value returned by recover() into an InternalServerError.
+// The error message is "panic: <recovered>", and the data is a map containing the recovered value under RecoveredPanicDataKey.
+// It returns nil if recovered is nil, so it can be called unconditionally in a deferred function.
+//
+// Example:
+//
+//	func doWork() (err error) {
+//	    defer func() {
+//	        if r := recover(); r != nil {
+//	            err = errors.RecoverToError(r)
+//	        }
+//	    }()
+//	    // Function logic...
+//	}
+func RecoverToError(recovered interface{}) error {
+	if recovered == nil {
+		return nil
+	}
+	return newRecoveredError(recovered, nil)
+}
+
+// RecoverToErrorWithStack behaves like RecoverToError, and also captures the current stack trace
+// under RecoveredStackDataKey in the error data. Call it from the deferred function that recovered
+// the panic so the stack includes the panicking frames.
+func RecoverToErrorWithStack(recovered interface{}) error {
+	if recovered == nil {
+		return nil
+	}
+	return newRecoveredError(recovered, debug.Stack())
+}
+
+// newRecoveredError creates an InternalServerError for the recovered value, including the stack if provided.
+func newRecoveredError(recovered interface{}, stack []byte) error {
+	panicValue := fmt.Sprintf("%v", recovered)
+	data := map[string]interface{}{
+		RecoveredPanicDataKey: panicValue,
+	}
+	if stack != nil {
+		data[RecoveredStackDataKey] = string(stack)
+	}
+	return NewInternalServerError("panic: "+panicValue, data)
+}
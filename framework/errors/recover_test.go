@@ -0,0 +1,94 @@
+package errors_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverToError(t *testing.T) {
+	type panicPayload struct {
+		ID   int
+		Name string
+	}
+
+	tests := []struct {
+		name            string
+		recovered       interface{}
+		expectedMessage string
+		expectedPanic   string
+	}{
+		{
+			name:            "should convert a string",
+			recovered:       "something went wrong",
+			expectedMessage: "panic: something went wrong",
+			expectedPanic:   "something went wrong",
+		},
+		{
+			name:            "should convert an error",
+			recovered:       errors.New("boom"),
+			expectedMessage: "panic: boom",
+			expectedPanic:   "boom",
+		},
+		{
+			name:            "should convert an arbitrary struct",
+			recovered:       panicPayload{ID: 1, Name: "payload"},
+			expectedMessage: "panic: {1 payload}",
+			expectedPanic:   "{1 payload}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			func() {
+				defer func() {
+					err = domain_error.RecoverToError(recover())
+				}()
+				panic(tt.recovered)
+			}()
+			require.Error(t, err)
+
+			internalErr, ok := err.(*domain_error.InternalServerError)
+			require.True(t, ok, "Expected error to be of type InternalServerError")
+			assert.Equal(t, http.StatusInternalServerError, internalErr.GetHTTPCode(), "Unexpected HTTP code")
+			assert.Equal(t, tt.expectedMessage, internalErr.GetMessage(), "Unexpected error message")
+
+			data, ok := internalErr.GetData().(map[string]interface{})
+			require.True(t, ok, "Expected data to be a map")
+			assert.Equal(t, tt.expectedPanic, data[domain_error.RecoveredPanicDataKey], "Unexpected panic value")
+			assert.NotContains(t, data, domain_error.RecoveredStackDataKey, "Stack should not be included")
+		})
+	}
+
+	t.Run("should return nil when nothing was recovered", func(t *testing.T) {
+		assert.NoError(t, domain_error.RecoverToError(nil))
+		assert.NoError(t, domain_error.RecoverToErrorWithStack(nil))
+	})
+}
+
+func TestRecoverToErrorWithStack(t *testing.T) {
+	var err error
+	func() {
+		defer func() {
+			err = domain_error.RecoverToErrorWithStack(recover())
+		}()
+		panic("something went wrong")
+	}()
+	require.Error(t, err)
+
+	internalErr, ok := err.(*domain_error.InternalServerError)
+	require.True(t, ok, "Expected error to be of type InternalServerError")
+	assert.Equal(t, "panic: something went wrong", internalErr.GetMessage(), "Unexpected error message")
+
+	data, ok := internalErr.GetData().(map[string]interface{})
+	require.True(t, ok, "Expected data to be a map")
+	assert.Equal(t, "something went wrong", data[domain_error.RecoveredPanicDataKey], "Unexpected panic value")
+	stack, ok := data[domain_error.RecoveredStackDataKey].(string)
+	require.True(t, ok, "Expected stack to be a string")
+	assert.Contains(t, stack, "TestRecoverToErrorWithStack", "Stack should include the panicking function")
+}
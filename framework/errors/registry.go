@@ -0,0 +1,97 @@
+package errors
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	codeRegistryMu sync.RWMutex
+	codeRegistry   = newBuiltinCodeRegistry()
+	strictCodes    atomic.Bool
+)
+
+// newBuiltinCodeRegistry returns a registry pre-populated with the built-in codes, described by their default messages.
+func newBuiltinCodeRegistry() map[string]string {
+	registry := make(map[string]string, len(errorCodeToMessages))
+	for code, message := range errorCodeToMessages {
+		registry[code] = message
+	}
+	return registry
+}
+
+/*
+RegisterCode records a service-defined error code and its description in the code registry.
+It returns an error if the code does not follow the 'xyyzzz' convention, if its 'xyy' prefix is not a valid category,
+or if the code has already been registered (including the built-in codes, which are registered by default).
+
+Codes are typically registered once at startup, next to their constant definitions:
+
+	const StatusCodeUserNotFound = "402001"
+
+	func init() {
+		if err := errors.RegisterCode(StatusCodeUserNotFound, "User not found"); err != nil {
+			panic(err)
+		}
+	}
+*/
+func RegisterCode(code, description string) error {
+	if err := validateCode(code); err != nil {
+		return fmt.Errorf("code registration failed: %w", err)
+	}
+
+	codeRegistryMu.Lock()
+	defer codeRegistryMu.Unlock()
+
+	if existing, exists := codeRegistry[code]; exists {
+		return fmt.Errorf("code registration failed: code '%s' is already registered (%s)", code, existing)
+	}
+	codeRegistry[code] = description
+	return nil
+}
+
+// DescribeCode returns the description of a registered error code, and whether the code is registered.
+func DescribeCode(code string) (string, bool) {
+	codeRegistryMu.RLock()
+	defer codeRegistryMu.RUnlock()
+
+	description, exists := codeRegistry[code]
+	return description, exists
+}
+
+// SetStrictCodes enables or disables strict mode. When enabled, NewBaseError returns an error for codes
+// that have not been registered with RegisterCode. Strict mode is disabled by default.
+func SetStrictCodes(enabled bool) {
+	strictCodes.Store(enabled)
+}
+
+// IsStrictCodes reports whether strict mode is enabled.
+func IsStrictCodes() bool {
+	return strictCodes.Load()
+}
+
+// resetCodeRegistry restores the registry to the built-in codes and disables strict mode.
+// It is used by tests to isolate registrations, since the registry is process-global.
+func resetCodeRegistry() {
+	codeRegistryMu.Lock()
+	defer codeRegistryMu.Unlock()
+
+	codeRegistry = newBuiltinCodeRegistry()
+	strictCodes.Store(false)
+}
+
+// validateCode checks that the code follows the 'xyyzzz' convention and that its 'xyy' prefix is a valid category.
+func validateCode(code string) error {
+	// Validate the error code length
+	const codeLength = 6
+	if len(code) != codeLength {
+		return fmt.Errorf("error code '%s' must be exactly %d characters", code, codeLength)
+	}
+
+	// Validate the extracted category 'xyy'
+	if xyy := code[:3]; !IsValidCategory(xyy) {
+		return fmt.Errorf("invalid category '%s' in code '%s'", xyy, code)
+	}
+	return nil
+}
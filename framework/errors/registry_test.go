@@ -0,0 +1,103 @@
+package errors_test
+
+import (
+	"testing"
+
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterCode(t *testing.T) {
+	t.Cleanup(domain_error.ResetCodeRegistry)
+	require.NoError(t, domain_error.RegisterCode("402101", "User not found"))
+
+	description, ok := domain_error.DescribeCode("402101")
+	assert.True(t, ok)
+	assert.Equal(t, "User not found", description)
+
+	// Registering the same code again fails.
+	err := domain_error.RegisterCode("402101", "Order not found")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "code '402101' is already registered (User not found)")
+
+	// The original description is kept.
+	description, _ = domain_error.DescribeCode("402101")
+	assert.Equal(t, "User not found", description)
+}
+
+func TestRegisterCode_Builtin(t *testing.T) {
+	description, ok := domain_error.DescribeCode(domain_error.StatusCodeGenericBadRequestError)
+	assert.True(t, ok)
+	assert.Equal(t, "The request was invalid or cannot be served.", description)
+
+	err := domain_error.RegisterCode(domain_error.StatusCodeGenericBadRequestError, "Bad request")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is already registered")
+}
+
+func TestRegisterCode_InvalidCode(t *testing.T) {
+	tests := []struct {
+		name        string
+		code        string
+		expectedErr string
+	}{
+		{
+			name:        "invalid length",
+			code:        "40101",
+			expectedErr: "code registration failed: error code '40101' must be exactly 6 characters",
+		},
+		{
+			name:        "invalid category",
+			code:        "999001",
+			expectedErr: "code registration failed: invalid category '999' in code '999001'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := domain_error.RegisterCode(tt.code, "description")
+			require.Error(t, err)
+			assert.EqualError(t, err, tt.expectedErr)
+
+			_, ok := domain_error.DescribeCode(tt.code)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestDescribeCode_Unregistered(t *testing.T) {
+	description, ok := domain_error.DescribeCode("402999")
+	assert.False(t, ok)
+	assert.Empty(t, description)
+}
+
+func TestNewBaseError_StrictCodes(t *testing.T) {
+	t.Cleanup(domain_error.ResetCodeRegistry)
+	domain_error.SetStrictCodes(true)
+	assert.True(t, domain_error.IsStrictCodes())
+
+	// Unregistered codes are rejected.
+	_, err := domain_error.NewBaseError("402102", "not registered", nil)
+	require.Error(t, err)
+	assert.EqualError(t, err, "error creation failed: code '402102' is not registered")
+
+	// Registered and built-in codes are accepted.
+	require.NoError(t, domain_error.RegisterCode("402103", "Order not found"))
+	_, err = domain_error.NewBaseError("402103", "", nil)
+	require.NoError(t, err)
+
+	_, err = domain_error.NewBaseError(domain_error.StatusCodeGenericNotFoundError, "", nil)
+	require.NoError(t, err)
+
+	// Built-in constructors keep working in strict mode.
+	var notFoundErr *domain_error.NotFoundError
+	assert.ErrorAs(t, domain_error.NewNotFoundError("", nil), &notFoundErr)
+}
+
+func TestNewBaseError_NonStrictCodes(t *testing.T) {
+	assert.False(t, domain_error.IsStrictCodes())
+
+	_, err := domain_error.NewBaseError("402104", "not registered", nil)
+	require.NoError(t, err)
+}
@@ -0,0 +1,43 @@
+package errors
+
+/*
+Sanitize returns the DomainError found in the error chain in a form that is safe to expose to clients.
+
+  - Server errors (5xx) may carry internal details, such as queries or hostnames, in their message or data.
+    They are replaced by a *BaseError copy that keeps the code and HTTP status, with the default message
+    for the code and no data.
+  - Client errors (4xx) are returned unchanged, since their messages and data are meant for the client.
+  - Errors without a DomainError in the chain are replaced by a generic InternalServerError.
+
+It returns nil if err is nil. The original error is not modified, so it can still be logged in full.
+
+Example:
+
+	domainErr := errors.Sanitize(err)
+	c.JSON(domainErr.GetHTTPCode(), gin.H{"code": domainErr.Code(), "message": domainErr.GetMessage(), "data": domainErr.GetData()})
+*/
+func Sanitize(err error) DomainError {
+	if err == nil {
+		return nil
+	}
+
+	domainErr := UnwrapDomainError(err)
+	if domainErr == nil {
+		code := StatusCodeGenericInternalServerError
+		return &InternalServerError{BaseError: &BaseError{
+			code:     code,
+			message:  getDefaultMessages(code),
+			httpCode: GetCategoryHTTPStatus(code[:3]),
+		}}
+	}
+	if !IsServerError(domainErr) {
+		return domainErr
+	}
+
+	baseErr := ExtractBaseError(domainErr)
+	return &BaseError{
+		code:     baseErr.code,
+		message:  getDefaultMessages(baseErr.code),
+		httpCode: baseErr.httpCode,
+	}
+}
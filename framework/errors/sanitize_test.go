@@ -0,0 +1,63 @@
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitize_ServerError(t *testing.T) {
+	dbErr := domain_error.NewDatabaseError("query failed: SELECT * FROM users WHERE password = 'secret'", map[string]string{
+		"host": "db-primary.internal:5432",
+	})
+
+	sanitized := domain_error.Sanitize(fmt.Errorf("get user: %w", dbErr))
+	require.NotNil(t, sanitized)
+
+	assert.Equal(t, domain_error.GetFullCode(domain_error.StatusCodeGenericDatabaseError), sanitized.Code())
+	assert.Equal(t, http.StatusInternalServerError, sanitized.GetHTTPCode())
+	assert.Equal(t, "A database error occurred while processing the request.", sanitized.GetMessage())
+	assert.NotContains(t, sanitized.Error(), "secret")
+	assert.Nil(t, sanitized.GetData())
+
+	// The sanitized error is still recognized as a domain error.
+	assert.Equal(t, sanitized, domain_error.UnwrapDomainError(sanitized))
+
+	// The original error is not modified.
+	var originalErr *domain_error.DatabaseError
+	require.ErrorAs(t, dbErr, &originalErr)
+	assert.Contains(t, originalErr.GetMessage(), "secret")
+	assert.NotNil(t, originalErr.GetData())
+}
+
+func TestSanitize_ClientError(t *testing.T) {
+	badRequestErr := domain_error.NewBadRequestError("email is invalid", map[string]string{"field": "email"})
+
+	sanitized := domain_error.Sanitize(fmt.Errorf("create user: %w", badRequestErr))
+	require.NotNil(t, sanitized)
+
+	assert.Same(t, badRequestErr, sanitized)
+	assert.Equal(t, "email is invalid", sanitized.GetMessage())
+	assert.Equal(t, map[string]string{"field": "email"}, sanitized.GetData())
+}
+
+func TestSanitize_NonDomainError(t *testing.T) {
+	sanitized := domain_error.Sanitize(errors.New("dial tcp 10.0.0.5:5432: connection refused"))
+	require.NotNil(t, sanitized)
+
+	var internalErr *domain_error.InternalServerError
+	require.ErrorAs(t, sanitized, &internalErr)
+	assert.Equal(t, domain_error.GetFullCode(domain_error.StatusCodeGenericInternalServerError), sanitized.Code())
+	assert.Equal(t, http.StatusInternalServerError, sanitized.GetHTTPCode())
+	assert.Equal(t, "An internal server error occurred. Please try again later.", sanitized.GetMessage())
+	assert.Nil(t, sanitized.GetData())
+}
+
+func TestSanitize_Nil(t *testing.T) {
+	assert.Nil(t, domain_error.Sanitize(nil))
+}
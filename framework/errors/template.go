@@ -0,0 +1,67 @@
+package errors
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// templatePlaceholder matches named placeholders (e.g., "{id}") in message templates.
+var templatePlaceholder = regexp.MustCompile(`\{(\w+)\}`)
+
+/*
+NewFromTemplate creates a domain error whose message is built by replacing the named placeholders (e.g., "{id}")
+in the template with the matching values from data, formatted with %v. Placeholders without a matching key
+are left unchanged. The data is also kept on the error.
+
+The type of the returned error is determined by the category of the code (e.g., a '402' code produces a
+*NotFoundError and a '501' code a *DatabaseError). Codes of other categories (e.g., success codes) produce a *BaseError.
+
+Example:
+
+	err := errors.NewFromTemplate(errors.StatusCodeGenericNotFoundError, "User {id} not found", map[string]interface{}{"id": 42})
+	// err.Error() == "User 42 not found", and errors.As(err, new(*errors.NotFoundError)) == true
+*/
+func NewFromTemplate(code string, template string, data map[string]interface{}) error {
+	message := templatePlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		if value, ok := data[placeholder[1:len(placeholder)-1]]; ok {
+			return fmt.Sprintf("%v", value)
+		}
+		return placeholder
+	})
+
+	var errData interface{}
+	if data != nil {
+		errData = data
+	}
+	baseErr, err := NewBaseError(code, message, errData)
+	if err != nil {
+		return fmt.Errorf("BaseError creation failed: %w", err)
+	}
+
+	switch code[:3] {
+	case StatusCodeGenericClientError[:3]:
+		return &ClientError{BaseError: baseErr}
+	case StatusCodeGenericBadRequestError[:3]:
+		return &BadRequestError{BaseError: baseErr}
+	case StatusCodeGenericNotFoundError[:3]:
+		return &NotFoundError{BaseError: baseErr}
+	case StatusCodeGenericConflictError[:3]:
+		return &ConflictError{BaseError: baseErr}
+	case StatusCodeGenericUnprocessableEntityError[:3]:
+		return &UnprocessableEntityError{BaseError: baseErr}
+	case StatusCodeGenericInternalServerError[:3]:
+		return &InternalServerError{BaseError: baseErr}
+	case StatusCodeGenericDatabaseError[:3]:
+		return &DatabaseError{BaseError: baseErr}
+	case StatusCodeGenericThirdPartyError[:3]:
+		return &ThirdPartyError{BaseError: baseErr}
+	case StatusCodeGenericAuthError[:3]:
+		return &AuthenticationError{BaseError: baseErr}
+	case StatusCodeGenericUnauthorizedError[:3]:
+		return &UnauthorizedError{BaseError: baseErr}
+	case StatusCodeGenericForbiddenError[:3]:
+		return &ForbiddenError{BaseError: baseErr}
+	default:
+		return baseErr
+	}
+}
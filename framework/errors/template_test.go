@@ -0,0 +1,74 @@
+package errors_test
+
+import (
+	"net/http"
+	"testing"
+
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFromTemplate_Interpolation(t *testing.T) {
+	data := map[string]interface{}{"id": 42, "name": "alice"}
+
+	err := domain_error.NewFromTemplate(domain_error.StatusCodeGenericNotFoundError, "User {id} ({name}) not found in {region}", data)
+	require.Error(t, err)
+
+	var notFoundErr *domain_error.NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	// Placeholders without a matching key are left unchanged.
+	assert.Equal(t, "User 42 (alice) not found in {region}", notFoundErr.GetMessage())
+	assert.Equal(t, http.StatusNotFound, notFoundErr.GetHTTPCode())
+	assert.Equal(t, domain_error.GetFullCode(domain_error.StatusCodeGenericNotFoundError), notFoundErr.Code())
+	assert.Equal(t, data, notFoundErr.GetData())
+}
+
+func TestNewFromTemplate_Types(t *testing.T) {
+	tests := []struct {
+		code   string
+		assert func(t *testing.T, err error)
+	}{
+		{domain_error.StatusCodeGenericClientError, func(t *testing.T, err error) { assert.IsType(t, &domain_error.ClientError{}, err) }},
+		{domain_error.StatusCodeGenericBadRequestError, func(t *testing.T, err error) { assert.IsType(t, &domain_error.BadRequestError{}, err) }},
+		{"402001", func(t *testing.T, err error) { assert.IsType(t, &domain_error.NotFoundError{}, err) }},
+		{domain_error.StatusCodeGenericConflictError, func(t *testing.T, err error) { assert.IsType(t, &domain_error.ConflictError{}, err) }},
+		{domain_error.StatusCodeGenericUnprocessableEntityError, func(t *testing.T, err error) {
+			assert.IsType(t, &domain_error.UnprocessableEntityError{}, err)
+		}},
+		{domain_error.StatusCodeGenericInternalServerError, func(t *testing.T, err error) { assert.IsType(t, &domain_error.InternalServerError{}, err) }},
+		{domain_error.StatusCodeGenericDatabaseError, func(t *testing.T, err error) { assert.IsType(t, &domain_error.DatabaseError{}, err) }},
+		{domain_error.StatusCodeGenericThirdPartyError, func(t *testing.T, err error) { assert.IsType(t, &domain_error.ThirdPartyError{}, err) }},
+		{domain_error.StatusCodeGenericAuthError, func(t *testing.T, err error) { assert.IsType(t, &domain_error.AuthenticationError{}, err) }},
+		{domain_error.StatusCodeGenericUnauthorizedError, func(t *testing.T, err error) { assert.IsType(t, &domain_error.UnauthorizedError{}, err) }},
+		{domain_error.StatusCodeGenericForbiddenError, func(t *testing.T, err error) { assert.IsType(t, &domain_error.ForbiddenError{}, err) }},
+		{domain_error.StatusCodePartialSuccess, func(t *testing.T, err error) { assert.IsType(t, &domain_error.BaseError{}, err) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			err := domain_error.NewFromTemplate(tt.code, "Item {id}", map[string]interface{}{"id": 1})
+			require.Error(t, err)
+			tt.assert(t, err)
+			assert.Equal(t, "Item 1", err.Error())
+			assert.NotNil(t, domain_error.UnwrapDomainError(err))
+		})
+	}
+}
+
+func TestNewFromTemplate_InvalidCode(t *testing.T) {
+	err := domain_error.NewFromTemplate("999999", "Item {id}", nil)
+	require.Error(t, err)
+	assert.Nil(t, domain_error.UnwrapDomainError(err))
+	assert.Contains(t, err.Error(), "BaseError creation failed")
+}
+
+func TestNewFromTemplate_NilData(t *testing.T) {
+	err := domain_error.NewFromTemplate(domain_error.StatusCodeGenericConflictError, "Item {id} already exists", nil)
+	require.Error(t, err)
+	assert.Equal(t, "Item {id} already exists", err.Error())
+
+	domainErr := domain_error.UnwrapDomainError(err)
+	require.NotNil(t, domainErr)
+	assert.Nil(t, domainErr.GetData())
+}
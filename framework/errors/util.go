@@ -5,6 +5,7 @@ import (
 )
 
 // WrapErrorWithPrefix wraps the input error with a prefix. If the error is nil, it does nothing.
+// The error is wrapped with %w, so UnwrapDomainError and errors.As still find a DomainError in the chain.
 func WrapErrorWithPrefix(prefix string, errptr *error) {
 	if *errptr != nil {
 		*errptr = fmt.Errorf(prefix+": %w", *errptr)
@@ -49,3 +50,38 @@ func UnwrapDomainError(err error) DomainError {
 	}
 	return nil
 }
+
+// Error classes returned by Category.
+const (
+	CategoryClient  = "client"
+	CategoryServer  = "server"
+	CategoryUnknown = "unknown"
+)
+
+// Category classifies an error by the HTTP status class of the DomainError found in its chain.
+// It returns CategoryClient for 4xx statuses, CategoryServer for 5xx statuses, and CategoryUnknown
+// if the error is nil, contains no DomainError, or carries a non-error status.
+func Category(err error) string {
+	domainErr := UnwrapDomainError(err)
+	if domainErr == nil {
+		return CategoryUnknown
+	}
+	switch status := domainErr.GetHTTPCode(); {
+	case status >= 400 && status < 500:
+		return CategoryClient
+	case status >= 500 && status < 600:
+		return CategoryServer
+	default:
+		return CategoryUnknown
+	}
+}
+
+// IsClientError reports whether the error chain contains a DomainError with a 4xx HTTP status.
+func IsClientError(err error) bool {
+	return Category(err) == CategoryClient
+}
+
+// IsServerError reports whether the error chain contains a DomainError with a 5xx HTTP status.
+func IsServerError(err error) bool {
+	return Category(err) == CategoryServer
+}
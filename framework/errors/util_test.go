@@ -3,6 +3,7 @@ package errors_test
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
 	domain_error "github.com/kittipat1413/go-common/framework/errors"
@@ -63,6 +64,34 @@ func TestWrapErrorWithPrefix(t *testing.T) {
 	}
 }
 
+func TestWrapErrorWithPrefix_PreservesDomainError(t *testing.T) {
+	findUser := func() (err error) {
+		defer domain_error.WrapErrorWithPrefix("[findUser]", &err)
+		return domain_error.NewNotFoundError("user not found", map[string]string{"id": "42"})
+	}
+	handler := func() (err error) {
+		defer domain_error.WrapErrorWithPrefix("[handler]", &err)
+		return findUser()
+	}
+
+	err := handler()
+	require.Error(t, err)
+	assert.Equal(t, "[handler]: [findUser]: user not found", err.Error())
+
+	// UnwrapDomainError should still find the original NotFoundError.
+	domainErr := domain_error.UnwrapDomainError(err)
+	require.NotNil(t, domainErr, "expected DomainError to be found in the chain")
+	assert.Equal(t, http.StatusNotFound, domainErr.GetHTTPCode())
+	assert.Equal(t, domain_error.GetFullCode(domain_error.StatusCodeGenericNotFoundError), domainErr.Code())
+	assert.Equal(t, "user not found", domainErr.GetMessage())
+	assert.Equal(t, map[string]string{"id": "42"}, domainErr.GetData())
+
+	// errors.As should also match the concrete type.
+	var notFoundErr *domain_error.NotFoundError
+	require.True(t, errors.As(err, &notFoundErr), "expected errors.As to find NotFoundError")
+	assert.Equal(t, http.StatusNotFound, notFoundErr.GetHTTPCode())
+}
+
 func TestWrapError(t *testing.T) {
 	// MockDomainError is a mock implementation of the DomainError interface for testing.
 	type MockDomainError struct {
@@ -239,3 +268,45 @@ func TestUnwrapDomainError(t *testing.T) {
 		})
 	}
 }
+
+func TestCategory(t *testing.T) {
+	badRequestErr := domain_error.NewBadRequestError("", nil)
+	notFoundErr := domain_error.NewNotFoundError("", nil)
+	unprocessableErr := domain_error.NewUnprocessableEntityError("", nil)
+	unauthorizedErr := domain_error.NewUnauthorizedError("", nil)
+	forbiddenErr := domain_error.NewForbiddenError("", nil)
+	internalErr := domain_error.NewInternalServerError("", nil)
+	databaseErr := domain_error.NewDatabaseError("", nil)
+	thirdPartyErr := domain_error.NewThirdPartyError("", nil)
+	baseSuccess, err := domain_error.NewBaseError(domain_error.StatusCodeSuccess, "", nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		err              error
+		expectedCategory string
+		isClientError    bool
+		isServerError    bool
+	}{
+		{name: "BadRequestError", err: badRequestErr, expectedCategory: domain_error.CategoryClient, isClientError: true},
+		{name: "NotFoundError", err: notFoundErr, expectedCategory: domain_error.CategoryClient, isClientError: true},
+		{name: "UnprocessableEntityError", err: unprocessableErr, expectedCategory: domain_error.CategoryClient, isClientError: true},
+		{name: "UnauthorizedError", err: unauthorizedErr, expectedCategory: domain_error.CategoryClient, isClientError: true},
+		{name: "ForbiddenError", err: forbiddenErr, expectedCategory: domain_error.CategoryClient, isClientError: true},
+		{name: "InternalServerError", err: internalErr, expectedCategory: domain_error.CategoryServer, isServerError: true},
+		{name: "DatabaseError", err: databaseErr, expectedCategory: domain_error.CategoryServer, isServerError: true},
+		{name: "ThirdPartyError", err: thirdPartyErr, expectedCategory: domain_error.CategoryServer, isServerError: true},
+		{name: "wrapped ThirdPartyError", err: fmt.Errorf("wrapped: %w", thirdPartyErr), expectedCategory: domain_error.CategoryServer, isServerError: true},
+		{name: "success status", err: baseSuccess, expectedCategory: domain_error.CategoryUnknown},
+		{name: "plain error", err: errors.New("plain error"), expectedCategory: domain_error.CategoryUnknown},
+		{name: "nil error", err: nil, expectedCategory: domain_error.CategoryUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedCategory, domain_error.Category(tt.err))
+			assert.Equal(t, tt.isClientError, domain_error.IsClientError(tt.err))
+			assert.Equal(t, tt.isServerError, domain_error.IsServerError(tt.err))
+		})
+	}
+}
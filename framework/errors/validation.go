@@ -0,0 +1,53 @@
+package errors
+
+import (
+	stderrors "errors"
+
+	playground_validator "github.com/go-playground/validator/v10"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+// ValidationFailedMessage is the message of the UnprocessableEntityError returned by FromValidationError.
+const ValidationFailedMessage = "Validation failed"
+
+/*
+FromValidationError converts a validation failure into an UnprocessableEntityError (HTTP 422)
+whose data is a map of field path to error message (map[string]string).
+
+It detects the following errors anywhere in the error chain:
+  - validator.ValidationErrors, as returned by `Validator.ValidateStructDetailed`. The translated messages are used.
+  - go-playground validator.ValidationErrors, as returned by a raw `validator.Validate`. The untranslated messages are used.
+
+If err is nil, it returns nil. Any other error is returned unchanged.
+
+Example:
+
+	if err := v.ValidateStructDetailed(req); err != nil {
+		return errors.FromValidationError(err)
+		// data: {"email": "email must be a valid email address"}
+	}
+*/
+func FromValidationError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var fieldErrs validator.ValidationErrors
+	if stderrors.As(err, &fieldErrs) {
+		return NewUnprocessableEntityError(ValidationFailedMessage, fieldErrs.ToMap())
+	}
+
+	var rawErrs playground_validator.ValidationErrors
+	if stderrors.As(err, &rawErrs) {
+		data := make(map[string]string, len(rawErrs))
+		for _, fe := range rawErrs {
+			path := validator.FieldPath(fe)
+			if _, exists := data[path]; !exists {
+				data[path] = fe.Error()
+			}
+		}
+		return NewUnprocessableEntityError(ValidationFailedMessage, data)
+	}
+
+	return err
+}
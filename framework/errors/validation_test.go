@@ -0,0 +1,65 @@
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	playground_validator "github.com/go-playground/validator/v10"
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type createUserRequest struct {
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+func TestFromValidationError_ValidationErrors(t *testing.T) {
+	v, err := validator.NewValidator(validator.WithTagNameFunc(validator.JSONTagNameFunc))
+	require.NoError(t, err)
+
+	validationErr := v.ValidateStructDetailed(createUserRequest{Email: "not-an-email"})
+	require.Error(t, validationErr)
+
+	// The validation error is found even when wrapped.
+	err = domain_error.FromValidationError(fmt.Errorf("create user: %w", validationErr))
+	require.Error(t, err)
+
+	var unprocessableErr *domain_error.UnprocessableEntityError
+	require.ErrorAs(t, err, &unprocessableErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, unprocessableErr.GetHTTPCode())
+	assert.Equal(t, domain_error.GetFullCode(domain_error.StatusCodeGenericUnprocessableEntityError), unprocessableErr.Code())
+	assert.Equal(t, domain_error.ValidationFailedMessage, unprocessableErr.GetMessage())
+	assert.Equal(t, map[string]string{
+		"name":  "name is a required field",
+		"email": "email must be a valid email address",
+	}, unprocessableErr.GetData())
+}
+
+func TestFromValidationError_PlaygroundValidationErrors(t *testing.T) {
+	validationErr := playground_validator.New().Struct(createUserRequest{Name: "Alice"})
+	require.Error(t, validationErr)
+
+	err := domain_error.FromValidationError(validationErr)
+	require.Error(t, err)
+
+	var unprocessableErr *domain_error.UnprocessableEntityError
+	require.ErrorAs(t, err, &unprocessableErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, unprocessableErr.GetHTTPCode())
+
+	data, ok := unprocessableErr.GetData().(map[string]string)
+	require.True(t, ok)
+	require.Len(t, data, 1)
+	assert.Contains(t, data["Email"], "'required' tag")
+}
+
+func TestFromValidationError_OtherErrors(t *testing.T) {
+	assert.NoError(t, domain_error.FromValidationError(nil))
+
+	otherErr := errors.New("some error")
+	assert.Equal(t, otherErr, domain_error.FromValidationError(otherErr))
+}
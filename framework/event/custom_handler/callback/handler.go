@@ -23,6 +23,30 @@ const (
 	defaultRetryInterval   = 2 * time.Second
 )
 
+// CallbackOutcome decides which callback, if any, is sent after an event has been handled.
+type CallbackOutcome int
+
+const (
+	// CallbackOutcomeSuccess sends the callback to the SuccessURL.
+	CallbackOutcomeSuccess CallbackOutcome = iota
+	// CallbackOutcomeFailure sends the callback to the FailURL.
+	CallbackOutcomeFailure
+	// CallbackOutcomeSkip sends no callback.
+	CallbackOutcomeSkip
+)
+
+// ResultClassifier classifies the result of handling an event into a CallbackOutcome.
+// eventResult is the error returned by the business logic (nil on success).
+type ResultClassifier[T any] func(msg event.EventMessage[T], eventResult error) CallbackOutcome
+
+// defaultResultClassifier treats a nil error as success and any other error as failure.
+func defaultResultClassifier[T any](_ event.EventMessage[T], eventResult error) CallbackOutcome {
+	if eventResult != nil {
+		return CallbackOutcomeFailure
+	}
+	return CallbackOutcomeSuccess
+}
+
 // callbackConfig contains the configuration for sending callbacks
 type callbackConfig struct {
 	maxRetries      int
@@ -34,6 +58,7 @@ type callbackConfig struct {
 type callbackEventHandler[T any] struct {
 	httpClient     *http.Client
 	callbackConfig callbackConfig
+	classifier     ResultClassifier[T]
 	logger         common_logger.Logger
 }
 
@@ -50,7 +75,8 @@ func NewEventHandler[T any](opts ...Option[T]) event.EventHandler[T] {
 			retryInterval:   defaultRetryInterval,
 			callbackTimeout: defaultCallbackTimeout,
 		},
-		logger: nil,
+		classifier: defaultResultClassifier[T],
+		logger:     nil,
 	}
 
 	// Apply options
@@ -93,6 +119,17 @@ func WithLogger[T any](customLogger common_logger.Logger) Option[T] {
 	}
 }
 
+// WithResultClassifier sets a custom ResultClassifier that decides whether the success callback, the failure callback,
+// or no callback is sent after an event has been handled (e.g., to report a partial success to the SuccessURL).
+// By default, a nil error sends the success callback and any other error sends the failure callback.
+func WithResultClassifier[T any](classifier ResultClassifier[T]) Option[T] {
+	return func(eh *callbackEventHandler[T]) {
+		if classifier != nil {
+			eh.classifier = classifier
+		}
+	}
+}
+
 // UnmarshalEventMessage unmarshals the provided JSON data into a CallbackEventMessage.
 func (eh *callbackEventHandler[T]) UnmarshalEventMessage(data []byte) (event.EventMessage[T], error) {
 	var msg CallbackEventMessage[T]
@@ -113,35 +150,34 @@ func (eh *callbackEventHandler[T]) BeforeHandle(ctx context.Context, msg event.E
 func (eh *callbackEventHandler[T]) AfterHandle(ctx context.Context, msg event.EventMessage[T], eventResult error) error {
 	// Check if the event message includes callback information and handle the callback accordingly
 	if callbackMsg, ok := msg.(interface{ GetCallback() *CallbackInfo }); ok {
-		eh.handleCallback(ctx, eventResult, callbackMsg.GetCallback())
+		eh.handleCallback(ctx, eh.classifier(msg, eventResult), callbackMsg.GetCallback())
 	}
 	return nil
 }
 
 // HandleCallback handles the success and failure callback logic using the EventHandler's http.Client
-func (eh *callbackEventHandler[T]) handleCallback(ctx context.Context, err error, callback *CallbackInfo) {
+func (eh *callbackEventHandler[T]) handleCallback(ctx context.Context, outcome CallbackOutcome, callback *CallbackInfo) {
 	if callback == nil {
 		return
 	}
 
-	copyCtx := context.WithoutCancel(ctx)
-	if err != nil {
-		if callback.FailURL != "" {
-			go func() {
-				ctx, cancel := context.WithTimeout(copyCtx, eh.callbackConfig.callbackTimeout)
-				defer cancel()
-				eh.sendCallback(ctx, callback.FailURL)
-			}()
-		}
-	} else {
-		if callback.SuccessURL != "" {
-			go func() {
-				ctx, cancel := context.WithTimeout(copyCtx, eh.callbackConfig.callbackTimeout)
-				defer cancel()
-				eh.sendCallback(ctx, callback.SuccessURL)
-			}()
-		}
+	var url string
+	switch outcome {
+	case CallbackOutcomeSuccess:
+		url = callback.SuccessURL
+	case CallbackOutcomeFailure:
+		url = callback.FailURL
+	}
+	if url == "" {
+		return
 	}
+
+	copyCtx := context.WithoutCancel(ctx)
+	go func() {
+		ctx, cancel := context.WithTimeout(copyCtx, eh.callbackConfig.callbackTimeout)
+		defer cancel()
+		eh.sendCallback(ctx, url)
+	}()
 }
 
 // sendCallback sends a callback using the EventHandler's http.Client with retry logic
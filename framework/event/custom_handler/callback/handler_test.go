@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"sync/atomic"
@@ -236,6 +237,87 @@ func TestAfterHandle_NoRetriesOnBadRequest(t *testing.T) {
 	require.Equal(t, int32(1), totalAttempts)
 }
 
+func TestAfterHandle_ResultClassifier(t *testing.T) {
+	type SamplePayload struct {
+		Data string `json:"data"`
+	}
+
+	errPartial := errors.New("some items failed")
+
+	// classifier reports partial successes to the SuccessURL, skips callbacks for events marked "silent",
+	// and reports events marked "dry-run" as failures even when processing succeeded.
+	classifier := func(msg event.EventMessage[SamplePayload], eventResult error) callbackhandler.CallbackOutcome {
+		switch {
+		case msg.GetPayload().Data == "silent":
+			return callbackhandler.CallbackOutcomeSkip
+		case msg.GetPayload().Data == "dry-run":
+			return callbackhandler.CallbackOutcomeFailure
+		case eventResult == nil, errors.Is(eventResult, errPartial):
+			return callbackhandler.CallbackOutcomeSuccess
+		default:
+			return callbackhandler.CallbackOutcomeFailure
+		}
+	}
+
+	tests := []struct {
+		name        string
+		data        string
+		eventResult error
+		expectedURL string // empty if no callback is expected
+	}{
+		{name: "partial success sends success callback", data: "items", eventResult: fmt.Errorf("batch: %w", errPartial), expectedURL: "http://example.com/success"},
+		{name: "other error sends failure callback", data: "items", eventResult: errors.New("processing error"), expectedURL: "http://example.com/fail"},
+		{name: "nil error classified as failure sends failure callback", data: "dry-run", eventResult: nil, expectedURL: "http://example.com/fail"},
+		{name: "skip sends no callback", data: "silent", eventResult: errors.New("processing error"), expectedURL: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &callbackhandler.CallbackEventMessage[SamplePayload]{
+				BaseEventMessage: event.BaseEventMessage[SamplePayload]{
+					EventType: "test_event",
+					Timestamp: time.Now(),
+					Payload:   SamplePayload{Data: tt.data},
+					Metadata:  map[string]string{"version": "1.0"},
+				},
+				Callback: &callbackhandler.CallbackInfo{
+					SuccessURL: "http://example.com/success",
+					FailURL:    "http://example.com/fail",
+				},
+			}
+
+			var calls atomic.Int32
+			var calledURL atomic.Value
+			client := NewTestClient(func(req *http.Request) *http.Response {
+				calls.Add(1)
+				calledURL.Store(req.URL.String())
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString("OK")),
+					Header:     make(http.Header),
+				}
+			})
+
+			handler := callbackhandler.NewEventHandler(
+				callbackhandler.WithHTTPClient[SamplePayload](client),
+				callbackhandler.WithResultClassifier(classifier),
+			)
+
+			err := handler.AfterHandle(context.Background(), msg, tt.eventResult)
+			require.NoError(t, err)
+
+			if tt.expectedURL == "" {
+				// Wait briefly to make sure no callback is sent
+				time.Sleep(100 * time.Millisecond)
+				assert.Equal(t, int32(0), calls.Load())
+				return
+			}
+			require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+			assert.Equal(t, tt.expectedURL, calledURL.Load())
+		})
+	}
+}
+
 func TestUnmarshalEventMessage_Success(t *testing.T) {
 	type SamplePayload struct {
 		Data string `json:"data"`
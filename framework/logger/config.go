@@ -33,6 +33,20 @@ func (l LogLevel) IsValid() bool {
 	return ok
 }
 
+// fromLogrusLevel converts a logrus.Level to a LogLevel.
+// Levels without a LogLevel equivalent are mapped to the closest one (panic to FATAL, trace to DEBUG).
+func fromLogrusLevel(level logrus.Level) LogLevel {
+	for l, ll := range logrusLevelMapper {
+		if ll == level {
+			return l
+		}
+	}
+	if level < logrus.FatalLevel {
+		return FATAL
+	}
+	return DEBUG
+}
+
 const (
 	// DefaultEnvironmentKey is the default key used for the environment field in logs.
 	DefaultEnvironmentKey = "environment"
@@ -40,4 +54,26 @@ const (
 	DefaultServiceNameKey = "service_name"
 	// DefaultErrorKey is the default key used for the error field in logs.
 	DefaultErrorKey = "error"
+	// DefaultErrorCodeKey is the default key used for the domain error code field in logs (see Config.ExtractDomainErrors).
+	DefaultErrorCodeKey = "error_code"
+	// DefaultErrorDataKey is the default key used for the domain error data field in logs (see Config.ExtractDomainErrors).
+	DefaultErrorDataKey = "error_data"
+	// DefaultPanicKey is the default key used to mark logs produced by a recovered panic.
+	DefaultPanicKey = "panic"
+	// DefaultPanicValueKey is the default key used for the recovered panic value in logs.
+	DefaultPanicValueKey = "panic_value"
+	// DefaultPanicStackKey is the default key used for the stack trace captured when logging a recovered panic.
+	DefaultPanicStackKey = "panic_stack"
+	// DefaultDurationMsKey is the default key used for the duration, in milliseconds, logged by Timer.
+	DefaultDurationMsKey = "duration_ms"
+)
+
+// DefaultFatalExitCode is the default process exit code used by Fatal (see Config.FatalExitCode).
+const DefaultFatalExitCode = 1
+
+const (
+	// EnvironmentDevelopment is the environment name for which NewLogger defaults to the TextFormatter.
+	EnvironmentDevelopment = "development"
+	// EnvironmentLocal is the environment name for which NewLogger defaults to the TextFormatter.
+	EnvironmentLocal = "local"
 )
@@ -5,18 +5,60 @@ import (
 	"net/http"
 )
 
+// contextKey is the type of the key under which the Logger is stored in a context.
+// The key is private to this package and stable across releases; use NewContext (or ContextWithLogger)
+// and FromContext (or LoggerFromContext, FromContextOK) to access it, rather than relying on the key itself.
 type contextKey struct{}
 
 var loggerKey = &contextKey{}
 
 // FromContext retrieves the Logger from the context. It returns a default logger if the context doesn't have one.
 func FromContext(ctx context.Context) Logger {
-	if logger, ok := ctx.Value(loggerKey).(Logger); ok {
+	if logger, ok := FromContextOK(ctx); ok {
 		return logger
 	}
 	return NewDefaultLogger()
 }
 
+// FromContextOK retrieves the Logger from the context and reports whether one was stored.
+// Unlike FromContext, it returns nil and false if the context doesn't have a logger.
+func FromContextOK(ctx context.Context) (Logger, bool) {
+	logger, ok := ctx.Value(loggerKey).(Logger)
+	return logger, ok
+}
+
+// LoggerFromContext is an alias of FromContext.
+func LoggerFromContext(ctx context.Context) Logger {
+	return FromContext(ctx)
+}
+
+// FromContextWithTrace retrieves the Logger from the context (like FromContext) and binds the trace and span IDs
+// of the active span in the context as `trace_id` and `span_id` fields. If the context has no valid span,
+// the logger is returned unchanged.
+//
+// The bound fields are emitted on every log call, including calls made with a different context
+// (e.g., from a background goroutine), so the entries can still be correlated with the originating trace.
+// They are not treated as user fields, so they keep their names when Config.StrictFields is enabled.
+func FromContextWithTrace(ctx context.Context) Logger {
+	log := FromContext(ctx)
+	traceID, spanID := extractTraceIDs(ctx)
+	if traceID == nil && spanID == nil {
+		return log
+	}
+
+	fields := Fields{}
+	if traceID != nil {
+		fields[DefaultSJsonFmtTraceIDKey] = *traceID
+	}
+	if spanID != nil {
+		fields[DefaultSJsonFmtSpanIDKey] = *spanID
+	}
+	if l, ok := log.(*logger); ok {
+		return l.withTraceFields(fields)
+	}
+	return log.WithFields(fields)
+}
+
 // FromRequest retrieves the Logger from the HTTP request's context.
 func FromRequest(r *http.Request) Logger {
 	return FromContext(r.Context())
@@ -27,6 +69,11 @@ func NewContext(ctx context.Context, logger Logger) context.Context {
 	return context.WithValue(ctx, loggerKey, logger)
 }
 
+// ContextWithLogger is an alias of NewContext.
+func ContextWithLogger(ctx context.Context, logger Logger) context.Context {
+	return NewContext(ctx, logger)
+}
+
 // NewRequest returns a new *http.Request that carries the provided Logger.
 func NewRequest(r *http.Request, logger Logger) *http.Request {
 	return r.WithContext(NewContext(r.Context(), logger))
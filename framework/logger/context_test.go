@@ -1,12 +1,16 @@
 package logger_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 
 	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 func TestFromContextWithNoLogger(t *testing.T) {
@@ -30,6 +34,34 @@ func TestFromContextWithLogger(t *testing.T) {
 	assert.Equal(t, specificLogger, retrievedLogger)
 }
 
+func TestFromContextOK(t *testing.T) {
+	// A bare context has no logger.
+	retrievedLogger, ok := logger.FromContextOK(context.Background())
+	assert.False(t, ok)
+	assert.Nil(t, retrievedLogger)
+
+	// A context with a logger.
+	specificLogger := logger.NewNoopLogger()
+	retrievedLogger, ok = logger.FromContextOK(logger.NewContext(context.Background(), specificLogger))
+	assert.True(t, ok)
+	assert.Equal(t, specificLogger, retrievedLogger)
+}
+
+func TestContextWithLoggerAliases(t *testing.T) {
+	specificLogger := logger.NewNoopLogger()
+
+	// The aliases are interchangeable with NewContext and FromContext.
+	ctx := logger.ContextWithLogger(context.Background(), specificLogger)
+	assert.Equal(t, specificLogger, logger.LoggerFromContext(ctx))
+	assert.Equal(t, specificLogger, logger.FromContext(ctx))
+
+	ctx = logger.NewContext(context.Background(), specificLogger)
+	assert.Equal(t, specificLogger, logger.LoggerFromContext(ctx))
+
+	// LoggerFromContext falls back to a default logger.
+	assert.NotNil(t, logger.LoggerFromContext(context.Background()))
+}
+
 func TestFromRequest(t *testing.T) {
 	// Create a new HTTP request
 	req, _ := http.NewRequest("GET", "http://example.com", nil)
@@ -43,3 +75,49 @@ func TestFromRequest(t *testing.T) {
 	assert.NotNil(t, retrievedLogger)
 	assert.Equal(t, defaultLogger, retrievedLogger)
 }
+
+func TestFromContextWithTrace(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:     logger.INFO,
+		Formatter: &logrus.JSONFormatter{},
+		Output:    buffer,
+	})
+	assert.NoError(t, err)
+
+	tracerProvider := sdktrace.NewTracerProvider()
+	defer func() { _ = tracerProvider.Shutdown(context.Background()) }()
+	ctx, span := tracerProvider.Tracer("test-tracer").Start(logger.NewContext(context.Background(), log), "test-span")
+	defer span.End()
+
+	// Log with a context that has no span; the trace IDs must come from the bound fields.
+	logger.FromContextWithTrace(ctx).Info(context.Background(), "Info message", nil)
+
+	var logEntry map[string]interface{}
+	err = json.Unmarshal(buffer.Bytes(), &logEntry)
+	assert.NoError(t, err, "log entry should be valid JSON")
+	assert.Equal(t, span.SpanContext().TraceID().String(), logEntry["trace_id"], "trace_id should match")
+	assert.Equal(t, span.SpanContext().SpanID().String(), logEntry["span_id"], "span_id should match")
+}
+
+func TestFromContextWithTrace_NoSpan(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:     logger.INFO,
+		Formatter: &logrus.JSONFormatter{},
+		Output:    buffer,
+	})
+	assert.NoError(t, err)
+
+	ctx := logger.NewContext(context.Background(), log)
+	retrievedLogger := logger.FromContextWithTrace(ctx)
+	assert.Equal(t, log, retrievedLogger, "logger should be returned unchanged without a span")
+
+	retrievedLogger.Info(ctx, "Info message", nil)
+
+	var logEntry map[string]interface{}
+	err = json.Unmarshal(buffer.Bytes(), &logEntry)
+	assert.NoError(t, err, "log entry should be valid JSON")
+	assert.NotContains(t, logEntry, "trace_id")
+	assert.NotContains(t, logEntry, "span_id")
+}
@@ -0,0 +1,120 @@
+package logger
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Entry is a log entry passed to a LogHook.
+type Entry struct {
+	// Context is the context the entry was logged with.
+	Context context.Context
+	// Time is the time at which the entry was created.
+	Time time.Time
+	// Level is the log level of the entry.
+	Level LogLevel
+	// Message is the log message.
+	Message string
+	// Fields contains all fields of the entry, including logger fields and the error (under DefaultErrorKey), if any.
+	Fields Fields
+}
+
+// LogHook is invoked for every log entry emitted at one of its levels (e.g., to ship error logs to an
+// error tracker or to increment a metric). Hooks are configured through Config.Hooks.
+// Fire is called synchronously on the logging goroutine, so implementations should be fast and safe for concurrent use.
+type LogHook interface {
+	// Levels returns the log levels for which the hook is fired.
+	Levels() []LogLevel
+	// Fire is called with the log entry. A returned error is reported by logrus to stderr and does not stop logging.
+	Fire(entry Entry) error
+}
+
+// logrusHookAdapter adapts a LogHook to the logrus.Hook interface.
+type logrusHookAdapter struct {
+	hook LogHook
+}
+
+// Levels implements the logrus.Hook interface.
+func (a *logrusHookAdapter) Levels() []logrus.Level {
+	levels := a.hook.Levels()
+	logrusLevels := make([]logrus.Level, 0, len(levels))
+	for _, level := range levels {
+		if level.IsValid() {
+			logrusLevels = append(logrusLevels, level.ToLogrusLevel())
+		}
+	}
+	return logrusLevels
+}
+
+// Fire implements the logrus.Hook interface.
+func (a *logrusHookAdapter) Fire(entry *logrus.Entry) error {
+	fields := make(Fields, len(entry.Data))
+	for k, v := range entry.Data {
+		fields[k] = v
+	}
+	return a.hook.Fire(Entry{
+		Context: entry.Context,
+		Time:    entry.Time,
+		Level:   fromLogrusLevel(entry.Level),
+		Message: entry.Message,
+		Fields:  fields,
+	})
+}
+
+// CountingHook is a LogHook that counts log entries by level, e.g., for exporting log volume metrics.
+type CountingHook struct {
+	levels []LogLevel
+	mu     sync.RWMutex
+	counts map[LogLevel]int64
+}
+
+// NewCountingHook creates a CountingHook that counts entries at the given levels.
+// If no levels are provided, entries at all levels are counted.
+func NewCountingHook(levels ...LogLevel) *CountingHook {
+	if len(levels) == 0 {
+		levels = []LogLevel{DEBUG, INFO, WARN, ERROR, FATAL}
+	}
+	return &CountingHook{
+		levels: levels,
+		counts: make(map[LogLevel]int64),
+	}
+}
+
+// Levels implements the LogHook interface.
+func (h *CountingHook) Levels() []LogLevel {
+	return h.levels
+}
+
+// Fire implements the LogHook interface.
+func (h *CountingHook) Fire(entry Entry) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.counts[entry.Level]++
+	return nil
+}
+
+// Count returns the number of entries counted at the given level.
+func (h *CountingHook) Count(level LogLevel) int64 {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.counts[level]
+}
+
+// levelCounterHook is a LogHook that calls Config.LevelCounter for every emitted entry.
+type levelCounterHook struct {
+	counter func(level LogLevel)
+}
+
+// Levels implements the LogHook interface.
+func (h *levelCounterHook) Levels() []LogLevel {
+	return []LogLevel{DEBUG, INFO, WARN, ERROR, FATAL}
+}
+
+// Fire implements the LogHook interface.
+func (h *levelCounterHook) Fire(entry Entry) error {
+	h.counter(entry.Level)
+	return nil
+}
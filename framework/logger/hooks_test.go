@@ -0,0 +1,112 @@
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingHook records the entries it is fired with.
+type recordingHook struct {
+	levels  []logger.LogLevel
+	mu      sync.Mutex
+	entries []logger.Entry
+}
+
+func (h *recordingHook) Levels() []logger.LogLevel {
+	return h.levels
+}
+
+func (h *recordingHook) Fire(entry logger.Entry) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.entries = append(h.entries, entry)
+	return nil
+}
+
+func TestLogger_Hooks(t *testing.T) {
+	hook := &recordingHook{levels: []logger.LogLevel{logger.WARN, logger.ERROR}}
+	log, err := logger.NewLogger(logger.Config{
+		Level:       logger.DEBUG,
+		Output:      &bytes.Buffer{},
+		ServiceName: "hook-service",
+		Hooks:       []logger.LogHook{hook},
+	})
+	require.NoError(t, err)
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+	log.Debug(ctx, "Debug message", nil)
+	log.Info(ctx, "Info message", nil)
+	log.Warn(ctx, "Warn message", logger.Fields{"key": "value"})
+	log.Error(ctx, "Error message", errors.New("test error"), nil)
+
+	require.Len(t, hook.entries, 2, "hook should fire only for configured levels")
+
+	assert.Equal(t, logger.WARN, hook.entries[0].Level)
+	assert.Equal(t, "Warn message", hook.entries[0].Message)
+	assert.Equal(t, "value", hook.entries[0].Fields["key"], "entry should include call fields")
+	assert.Equal(t, "hook-service", hook.entries[0].Fields[logger.DefaultServiceNameKey], "entry should include logger fields")
+	assert.Equal(t, "value", hook.entries[0].Context.Value(ctxKey{}), "entry should carry the log context")
+	assert.False(t, hook.entries[0].Time.IsZero(), "entry should have a time")
+
+	assert.Equal(t, logger.ERROR, hook.entries[1].Level)
+	assert.Equal(t, "Error message", hook.entries[1].Message)
+	assert.EqualError(t, hook.entries[1].Fields[logger.DefaultErrorKey].(error), "test error", "entry should include the error")
+}
+
+func TestCountingHook(t *testing.T) {
+	hook := logger.NewCountingHook(logger.INFO, logger.ERROR)
+	log, err := logger.NewLogger(logger.Config{
+		Level:  logger.INFO,
+		Output: &bytes.Buffer{},
+		Hooks:  []logger.LogHook{hook},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	log.Debug(ctx, "Suppressed debug message", nil)
+	log.Info(ctx, "Info message", nil)
+	log.Info(ctx, "Info message", nil)
+	log.Warn(ctx, "Warn message", nil)
+	log.Error(ctx, "Error message", errors.New("test error"), nil)
+
+	assert.Equal(t, int64(0), hook.Count(logger.DEBUG), "suppressed entries should not be counted")
+	assert.Equal(t, int64(2), hook.Count(logger.INFO))
+	assert.Equal(t, int64(0), hook.Count(logger.WARN), "levels not configured should not be counted")
+	assert.Equal(t, int64(1), hook.Count(logger.ERROR))
+}
+
+func TestLogger_LevelCounter(t *testing.T) {
+	var mu sync.Mutex
+	counts := map[logger.LogLevel]int{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:  logger.WARN,
+		Output: &bytes.Buffer{},
+		LevelCounter: func(level logger.LogLevel) {
+			mu.Lock()
+			defer mu.Unlock()
+			counts[level]++
+		},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	log.Debug(ctx, "suppressed", nil)
+	log.Info(ctx, "suppressed", nil)
+	log.Warn(ctx, "emitted", nil)
+	log.Error(ctx, "emitted", errors.New("boom"), nil)
+	log.WithFields(logger.Fields{"key": "value"}).Error(ctx, "emitted", nil, nil)
+	log.Panic(ctx, "recovered", nil)
+
+	assert.Equal(t, map[logger.LogLevel]int{
+		logger.WARN:  1,
+		logger.ERROR: 3,
+	}, counts)
+}
@@ -0,0 +1,37 @@
+package logger
+
+// LazyValue is a field value computed only when the log entry is emitted. Create it with Lazy.
+type LazyValue struct {
+	fn func() interface{}
+}
+
+/*
+Lazy returns a field value that is computed by fn only if the entry is emitted at the logger's configured level,
+avoiding expensive work (e.g., serializing a large struct) for disabled levels. fn is called at most once per entry.
+
+Example:
+
+	log.Debug(ctx, "Cart loaded", logger.Fields{
+		"cart": logger.Lazy(func() interface{} { return cart.Summary() }),
+	})
+*/
+func Lazy(fn func() interface{}) LazyValue {
+	return LazyValue{fn: fn}
+}
+
+// resolve returns the value computed by the function, or nil if there is none.
+func (v LazyValue) resolve() interface{} {
+	if v.fn == nil {
+		return nil
+	}
+	return v.fn()
+}
+
+// resolveLazyFields replaces the lazy values in fields with their computed values.
+func resolveLazyFields(fields Fields) {
+	for key, value := range fields {
+		if lazy, ok := value.(LazyValue); ok {
+			fields[key] = lazy.resolve()
+		}
+	}
+}
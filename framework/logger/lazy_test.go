@@ -0,0 +1,67 @@
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLazy(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:     logger.INFO,
+		Formatter: &logger.StructuredJSONFormatter{TimestampFormat: time.RFC3339},
+		Output:    buffer,
+	})
+	require.NoError(t, err)
+
+	var calls int
+	expensive := logger.Lazy(func() interface{} {
+		calls++
+		return map[string]int{"items": 3}
+	})
+	ctx := context.Background()
+
+	// Below the configured level: the function is not called.
+	log.Debug(ctx, "Cart loaded", logger.Fields{"cart": expensive})
+	assert.Equal(t, 0, calls)
+	assert.Empty(t, buffer.String())
+
+	// At the configured level: the function is called once and its result is logged.
+	log.Info(ctx, "Cart loaded", logger.Fields{"cart": expensive})
+	assert.Equal(t, 1, calls)
+
+	var logEntry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &logEntry), "log entry should be valid JSON")
+	assert.Equal(t, map[string]interface{}{"items": float64(3)}, logEntry["cart"])
+
+	// Above the configured level, including persistent fields.
+	buffer.Reset()
+	log.WithFields(logger.Fields{"cart": expensive}).Error(ctx, "Checkout failed", nil, nil)
+	assert.Equal(t, 2, calls)
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &logEntry), "log entry should be valid JSON")
+	assert.Equal(t, map[string]interface{}{"items": float64(3)}, logEntry["cart"])
+}
+
+func TestLazy_NilFunction(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:     logger.INFO,
+		Formatter: &logger.StructuredJSONFormatter{TimestampFormat: time.RFC3339},
+		Output:    buffer,
+	})
+	require.NoError(t, err)
+
+	log.Info(context.Background(), "message", logger.Fields{"value": logger.Lazy(nil)})
+
+	var logEntry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &logEntry), "log entry should be valid JSON")
+	assert.Contains(t, logEntry, "value")
+	assert.Nil(t, logEntry["value"])
+}
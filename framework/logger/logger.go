@@ -3,11 +3,14 @@ package logger
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -19,6 +22,7 @@ type Logger interface {
 	Warn(ctx context.Context, msg string, fields Fields)
 	Error(ctx context.Context, msg string, err error, fields Fields)
 	Fatal(ctx context.Context, msg string, err error, fields Fields)
+	Panic(ctx context.Context, recovered interface{}, fields Fields)
 }
 
 var (
@@ -83,9 +87,12 @@ func NewDefaultLogger() Logger {
 
 // logger is the implementation of the Logger interface.
 type logger struct {
-	baselogger *logrus.Logger
-	logLevel   LogLevel
-	fields     Fields
+	baselogger          *logrus.Logger
+	logLevel            LogLevel
+	fields              Fields
+	extractDomainErrors bool
+	strictFields        *strictFields // nil unless Config.StrictFields is enabled.
+	traceFields         Fields        // Trace and span IDs bound by FromContextWithTrace; never renamed by StrictFields.
 }
 
 // Config holds the logger configuration.
@@ -94,7 +101,8 @@ type Config struct {
 	// Logs with a level lower than this will be ignored.
 	Level LogLevel
 	// Formatter is an optional field for specifying a custom logrus formatter.
-	// If not provided, the logger will use the StructuredJSONFormatter by default.
+	// If not provided, the logger will use the TextFormatter when Environment is "development" or "local",
+	// and the StructuredJSONFormatter otherwise. Setting a formatter explicitly always takes precedence.
 	Formatter logrus.Formatter
 	// Environment is an optional field for specifying the running environment (e.g., "production", "staging").
 	// This field is used for adding environment-specific fields to logs.
@@ -105,21 +113,45 @@ type Config struct {
 	// Output is an optional field for specifying the output destination for logs (e.g., os.Stdout, file).
 	// If not provided, logs will be written to stdout by default.
 	Output io.Writer
+	// Hooks is an optional list of hooks fired for log entries at their configured levels
+	// (e.g., to ship error logs to an error tracker or to count entries by level).
+	Hooks []LogHook
+	// LevelCounter is an optional function called with the level of every emitted log entry, after level filtering
+	// (e.g., to increment a Prometheus counter per level for alerting on error-rate spikes).
+	// It is called synchronously on the logging goroutine, so it should be fast and safe for concurrent use.
+	LevelCounter func(level LogLevel)
+	// ExtractDomainErrors enables structured logging of domain errors (see the framework/errors package).
+	// When enabled, Error and Fatal add the error code (`error_code`) and, if present, the error data (`error_data`)
+	// of the first DomainError found in the error chain.
+	ExtractDomainErrors bool
+	// StrictFields enables detection of caller-provided fields that collide with the reserved keys of the standard fields
+	// (e.g., "message" or "severity"). Colliding fields are renamed with the "fields." prefix instead of overwriting
+	// the standard field, and a one-time warning is logged per key. It is intended for development environments.
+	StrictFields bool
+	// FatalExitCode is the process exit code used by Fatal. Defaults to DefaultFatalExitCode (1) if zero.
+	FatalExitCode int
+	// FatalHook is an optional function called by Fatal after the entry is written and before the process exits
+	// (e.g., to flush buffered logs or shut down the tracer provider).
+	FatalHook func()
+	// ExitFunc is an optional function called by Fatal to exit the process with the exit code.
+	// Defaults to os.Exit; override it in tests to observe Fatal without exiting.
+	ExitFunc func(code int)
 }
 
 // NewLogger creates a new logger instance with the provided configuration.
 func NewLogger(config Config) (Logger, error) {
 	logrusLogger := logrus.New()
 
-	// Set custom formatter if provided, otherwise use StructuredJSONFormatter.
-	if config.Formatter != nil {
-		logrusLogger.SetFormatter(config.Formatter)
-	} else {
-		logrusLogger.SetFormatter(&StructuredJSONFormatter{
-			TimestampFormat: time.RFC3339,
-			PrettyPrint:     false,
-		})
+	// Set custom formatter if provided, otherwise select a default based on the environment.
+	// The formatter is wrapped so that a panic or error while formatting produces a fallback line instead.
+	formatter := config.Formatter
+	if formatter == nil {
+		formatter = defaultFormatterForEnvironment(config.Environment)
 	}
+	if _, ok := formatter.(*SafeFormatter); !ok {
+		formatter = &SafeFormatter{Formatter: formatter}
+	}
+	logrusLogger.SetFormatter(formatter)
 
 	// Set log level.
 	if !config.Level.IsValid() {
@@ -134,6 +166,33 @@ func NewLogger(config Config) (Logger, error) {
 		logrusLogger.SetOutput(os.Stdout)
 	}
 
+	// Register hooks.
+	for _, hook := range config.Hooks {
+		if hook != nil {
+			logrusLogger.AddHook(&logrusHookAdapter{hook: hook})
+		}
+	}
+	if config.LevelCounter != nil {
+		logrusLogger.AddHook(&logrusHookAdapter{hook: &levelCounterHook{counter: config.LevelCounter}})
+	}
+
+	// Run the fatal hook and exit with the configured code when Fatal is called.
+	exitCode := config.FatalExitCode
+	if exitCode == 0 {
+		exitCode = DefaultFatalExitCode
+	}
+	exit := config.ExitFunc
+	if exit == nil {
+		exit = os.Exit
+	}
+	fatalHook := config.FatalHook
+	logrusLogger.ExitFunc = func(int) {
+		if fatalHook != nil {
+			fatalHook()
+		}
+		exit(exitCode)
+	}
+
 	// Add environment and service name fields to the logger.
 	fields := make(Fields)
 	if config.Environment != "" {
@@ -143,13 +202,36 @@ func NewLogger(config Config) (Logger, error) {
 		fields[DefaultServiceNameKey] = config.ServiceName
 	}
 
+	var strict *strictFields
+	if config.StrictFields {
+		strict = &strictFields{}
+	}
+
 	return &logger{
-		baselogger: logrusLogger,
-		logLevel:   config.Level,
-		fields:     fields,
+		baselogger:          logrusLogger,
+		logLevel:            config.Level,
+		fields:              fields,
+		extractDomainErrors: config.ExtractDomainErrors,
+		strictFields:        strict,
 	}, nil
 }
 
+// defaultFormatterForEnvironment returns the TextFormatter for development environments ("development", "local"),
+// and the StructuredJSONFormatter for any other environment.
+func defaultFormatterForEnvironment(environment string) logrus.Formatter {
+	switch strings.ToLower(strings.TrimSpace(environment)) {
+	case EnvironmentDevelopment, EnvironmentLocal:
+		return &TextFormatter{
+			TimestampFormat: time.RFC3339,
+		}
+	default:
+		return &StructuredJSONFormatter{
+			TimestampFormat: time.RFC3339,
+			PrettyPrint:     false,
+		}
+	}
+}
+
 // clone creates a deep copy of the logger.
 func (l *logger) clone() *logger {
 	c := *l
@@ -174,6 +256,14 @@ func (l *logger) WithFields(fields Fields) Logger {
 	return clone
 }
 
+// withTraceFields returns a new logger that emits the provided trace fields on every log call.
+// Unlike fields added with WithFields, they are written by the logger itself and are not renamed under StrictFields.
+func (l *logger) withTraceFields(fields Fields) *logger {
+	clone := l.clone()
+	clone.traceFields = fields
+	return clone
+}
+
 // Debug logs a message at the Debug level.
 func (l *logger) Debug(ctx context.Context, msg string, fields Fields) {
 	l.logWithContext(ctx, logrus.DebugLevel, msg, fields)
@@ -191,38 +281,74 @@ func (l *logger) Warn(ctx context.Context, msg string, fields Fields) {
 
 // Error logs a message at the Error level.
 func (l *logger) Error(ctx context.Context, msg string, err error, fields Fields) {
+	l.logWithContext(ctx, logrus.ErrorLevel, msg, l.withErrorFields(fields, err))
+}
+
+// Fatal logs a message at the Fatal level, runs the configured fatal hook, and exits the application
+// with the configured exit code.
+func (l *logger) Fatal(ctx context.Context, msg string, err error, fields Fields) {
+	l.logWithContext(ctx, logrus.FatalLevel, msg, l.withErrorFields(fields, err))
+}
+
+// withErrorFields adds the error, and the domain error code and data if ExtractDomainErrors is enabled, to the fields.
+func (l *logger) withErrorFields(fields Fields, err error) Fields {
 	if fields == nil {
 		fields = Fields{}
 	}
-	if err != nil {
-		fields[DefaultErrorKey] = err
+	if err == nil {
+		return fields
+	}
+	fields[DefaultErrorKey] = err
+	if l.extractDomainErrors {
+		if domainErr := domain_error.UnwrapDomainError(err); domainErr != nil {
+			fields[DefaultErrorCodeKey] = domainErr.Code()
+			if data := domainErr.GetData(); data != nil {
+				fields[DefaultErrorDataKey] = data
+			}
+		}
 	}
-	l.logWithContext(ctx, logrus.ErrorLevel, msg, fields)
+	return fields
 }
 
-// Fatal logs a message at the Fatal level and exits the application.
-func (l *logger) Fatal(ctx context.Context, msg string, err error, fields Fields) {
-	if fields == nil {
-		fields = Fields{}
+// Panic logs a recovered panic value at the Error level without exiting the application.
+// The entry is marked with a `panic: true` field and includes the recovered value and the stack trace captured at the time of the call.
+func (l *logger) Panic(ctx context.Context, recovered interface{}, fields Fields) {
+	panicFields := make(Fields, len(fields)+4)
+	for k, v := range fields {
+		panicFields[k] = v
 	}
-	if err != nil {
-		fields[DefaultErrorKey] = err
+	panicFields[DefaultPanicKey] = true
+	panicFields[DefaultPanicValueKey] = fmt.Sprintf("%v", recovered)
+	panicFields[DefaultPanicStackKey] = getStackTrace()
+	if err, ok := recovered.(error); ok {
+		panicFields[DefaultErrorKey] = err
 	}
-	l.logWithContext(ctx, logrus.FatalLevel, msg, fields)
+	l.logWithContext(ctx, logrus.ErrorLevel, fmt.Sprintf("Panic recovered: %v", recovered), panicFields)
 }
 
 // logWithContext logs a message with the provided context and fields.
 func (l *logger) logWithContext(ctx context.Context, level logrus.Level, msg string, fields Fields) {
+	// Skip disabled levels before merging fields, so that lazy values are not computed.
+	if !l.baselogger.IsLevelEnabled(level) {
+		return
+	}
 	entry := l.baselogger.WithContext(ctx)
 
 	// Merge logger's fields with input fields.
-	mergedFields := make(Fields, len(l.fields)+len(fields))
+	mergedFields := make(Fields, len(l.fields)+len(fields)+len(l.traceFields))
 	for k, v := range l.fields {
 		mergedFields[k] = v
 	}
 	for k, v := range fields {
 		mergedFields[k] = v
 	}
+	if l.strictFields != nil {
+		l.warnCollisions(ctx, l.strictFields.rename(mergedFields))
+	}
+	for k, v := range l.traceFields {
+		mergedFields[k] = v
+	}
+	resolveLazyFields(mergedFields)
 	entry = entry.WithFields(logrus.Fields(mergedFields))
 
 	// Log the message at the specified level.
@@ -252,3 +378,4 @@ func (n *noopLogger) Info(ctx context.Context, msg string, fields Fields)
 func (n *noopLogger) Warn(ctx context.Context, msg string, fields Fields)             {}
 func (n *noopLogger) Error(ctx context.Context, msg string, err error, fields Fields) {}
 func (n *noopLogger) Fatal(ctx context.Context, msg string, err error, fields Fields) {}
+func (n *noopLogger) Panic(ctx context.Context, recovered interface{}, fields Fields) {}
@@ -5,12 +5,15 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"testing"
 	"time"
 
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
 	"github.com/kittipat1413/go-common/framework/logger"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewDefaultLogger(t *testing.T) {
@@ -113,6 +116,148 @@ func TestLogger_ErrorLevel(t *testing.T) {
 	assert.Equal(t, "test error", logEntry["error"], "error message should match")
 }
 
+func TestLogger_ExtractDomainErrors(t *testing.T) {
+	notFoundErr := domain_error.NewNotFoundError("user not found", map[string]string{"user_id": "42"})
+	wrappedErr := fmt.Errorf("get user: %w", notFoundErr)
+
+	tests := []struct {
+		name                string
+		extractDomainErrors bool
+		err                 error
+		expectedCode        interface{}
+		expectedData        interface{}
+	}{
+		{
+			name:                "domain error",
+			extractDomainErrors: true,
+			err:                 notFoundErr,
+			expectedCode:        domain_error.GetFullCode(domain_error.StatusCodeGenericNotFoundError),
+			expectedData:        map[string]interface{}{"user_id": "42"},
+		},
+		{
+			name:                "wrapped domain error",
+			extractDomainErrors: true,
+			err:                 wrappedErr,
+			expectedCode:        domain_error.GetFullCode(domain_error.StatusCodeGenericNotFoundError),
+			expectedData:        map[string]interface{}{"user_id": "42"},
+		},
+		{
+			name:                "domain error without data",
+			extractDomainErrors: true,
+			err:                 domain_error.NewNotFoundError("user not found", nil),
+			expectedCode:        domain_error.GetFullCode(domain_error.StatusCodeGenericNotFoundError),
+			expectedData:        nil,
+		},
+		{
+			name:                "non-domain error",
+			extractDomainErrors: true,
+			err:                 errors.New("plain error"),
+			expectedCode:        nil,
+			expectedData:        nil,
+		},
+		{
+			name:                "disabled",
+			extractDomainErrors: false,
+			err:                 notFoundErr,
+			expectedCode:        nil,
+			expectedData:        nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			log, err := logger.NewLogger(logger.Config{
+				Level: logger.INFO,
+				Formatter: &logger.StructuredJSONFormatter{
+					TimestampFormat: time.RFC3339,
+				},
+				Output:              buffer,
+				ExtractDomainErrors: tt.extractDomainErrors,
+			})
+			require.NoError(t, err)
+
+			log.Error(context.Background(), "Failed to get user", tt.err, nil)
+
+			var logEntry map[string]interface{}
+			require.NoError(t, json.Unmarshal(buffer.Bytes(), &logEntry), "log entry should be valid JSON")
+
+			assert.Equal(t, tt.err.Error(), logEntry[logger.DefaultErrorKey])
+			assert.Equal(t, tt.expectedCode, logEntry[logger.DefaultErrorCodeKey])
+			assert.Equal(t, tt.expectedData, logEntry[logger.DefaultErrorDataKey])
+		})
+	}
+}
+
+func TestLogger_Panic(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level: logger.INFO,
+		Formatter: &logger.StructuredJSONFormatter{
+			TimestampFormat: time.RFC3339,
+			PrettyPrint:     false,
+		},
+		Output: buffer,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, log)
+
+	ctx := context.Background()
+	fields := logger.Fields{"job": "sync"}
+
+	assert.NotPanics(t, func() {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Panic(ctx, r, fields)
+			}
+		}()
+		panic(errors.New("something went wrong"))
+	})
+
+	logEntries := bytes.Split(buffer.Bytes(), []byte("\n"))
+	if len(logEntries) > 0 && len(logEntries[len(logEntries)-1]) == 0 {
+		logEntries = logEntries[:len(logEntries)-1]
+	}
+
+	assert.Equal(t, 1, len(logEntries), "should have 1 log entry")
+
+	var logEntry map[string]interface{}
+	err = json.Unmarshal(logEntries[0], &logEntry)
+	assert.NoError(t, err, "log entry should be valid JSON")
+
+	assert.Equal(t, "error", logEntry["severity"], "log level should be 'error'")
+	assert.Equal(t, "Panic recovered: something went wrong", logEntry["message"], "log message should match")
+	assert.Equal(t, true, logEntry["panic"], "panic field should be true")
+	assert.Equal(t, "something went wrong", logEntry["panic_value"], "panic value should match")
+	assert.Equal(t, "something went wrong", logEntry["error"], "error should be set when the recovered value is an error")
+	assert.Equal(t, "sync", logEntry["job"], "custom field should be preserved")
+	assert.NotEmpty(t, logEntry["panic_stack"], "panic stack should be present")
+	assert.NotContains(t, logEntry, "stack_trace", "the stack should not be written twice")
+	_, hasPanicKey := fields["panic"]
+	assert.False(t, hasPanicKey, "caller's fields should not be modified")
+}
+
+func TestLogger_PanicNonErrorValue(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:     logger.INFO,
+		Formatter: &logger.StructuredJSONFormatter{TimestampFormat: time.RFC3339},
+		Output:    buffer,
+	})
+	assert.NoError(t, err)
+
+	log.Panic(context.Background(), 42, nil)
+
+	var logEntry map[string]interface{}
+	err = json.Unmarshal(bytes.TrimSpace(buffer.Bytes()), &logEntry)
+	assert.NoError(t, err, "log entry should be valid JSON")
+
+	assert.Equal(t, true, logEntry["panic"], "panic field should be true")
+	assert.Equal(t, "42", logEntry["panic_value"], "panic value should be formatted")
+	assert.NotContains(t, logEntry, "error", "error field should not be set for non-error values")
+	assert.NotEmpty(t, logEntry["panic_stack"], "panic stack should be present")
+}
+
 func TestLogger_NilFields(t *testing.T) {
 	buffer := &bytes.Buffer{}
 	log, err := logger.NewLogger(logger.Config{
@@ -338,7 +483,51 @@ func TestNoopLogger(t *testing.T) {
 		log.Info(ctx, "Info message", fields)
 		log.Warn(ctx, "Warn message", fields)
 		log.Error(ctx, "Error message", errors.New("test error"), fields)
+		log.Panic(ctx, "panic value", fields)
 		// Commenting out Fatal to avoid calling os.Exit in tests
 		// log.Fatal(ctx, "Fatal message", errors.New("test error"), fields)
 	}, "noopLogger methods should not panic")
 }
+
+func TestLogger_Fatal(t *testing.T) {
+	t.Run("runs the hook before exiting with the configured code", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		var calls []string
+		exitCode := -1
+		log, err := logger.NewLogger(logger.Config{
+			Level:         logger.INFO,
+			Formatter:     &logger.StructuredJSONFormatter{},
+			Output:        buffer,
+			FatalExitCode: 3,
+			FatalHook: func() {
+				// The entry is written before the hook runs.
+				assert.Contains(t, buffer.String(), "Fatal message")
+				calls = append(calls, "hook")
+			},
+			ExitFunc: func(code int) {
+				calls = append(calls, "exit")
+				exitCode = code
+			},
+		})
+		require.NoError(t, err)
+
+		log.Fatal(context.Background(), "Fatal message", errors.New("fatal error"), nil)
+
+		assert.Equal(t, []string{"hook", "exit"}, calls)
+		assert.Equal(t, 3, exitCode)
+	})
+
+	t.Run("defaults to exit code 1", func(t *testing.T) {
+		exitCode := -1
+		log, err := logger.NewLogger(logger.Config{
+			Level:    logger.INFO,
+			Output:   &bytes.Buffer{},
+			ExitFunc: func(code int) { exitCode = code },
+		})
+		require.NoError(t, err)
+
+		log.Fatal(context.Background(), "Fatal message", nil, nil)
+
+		assert.Equal(t, logger.DefaultFatalExitCode, exitCode)
+	})
+}
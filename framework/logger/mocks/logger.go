@@ -83,6 +83,18 @@ func (mr *MockLoggerMockRecorder) Info(ctx, msg, fields interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Info", reflect.TypeOf((*MockLogger)(nil).Info), ctx, msg, fields)
 }
 
+// Panic mocks base method.
+func (m *MockLogger) Panic(ctx context.Context, recovered interface{}, fields logger.Fields) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Panic", ctx, recovered, fields)
+}
+
+// Panic indicates an expected call of Panic.
+func (mr *MockLoggerMockRecorder) Panic(ctx, recovered, fields interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Panic", reflect.TypeOf((*MockLogger)(nil).Panic), ctx, recovered, fields)
+}
+
 // Warn mocks base method.
 func (m *MockLogger) Warn(ctx context.Context, msg string, fields logger.Fields) {
 	m.ctrl.T.Helper()
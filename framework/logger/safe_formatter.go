@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultFormatterErrorKey is the key used for the formatter failure note in fallback log lines written by SafeFormatter.
+const DefaultFormatterErrorKey = "formatter_error"
+
+/*
+SafeFormatter wraps a logrus formatter so that a failure during Format never takes down the application
+or silently drops the entry. If the wrapped formatter panics (e.g., a buggy custom FieldKeyFormatter) or returns
+an error (e.g., a field value that cannot be marshaled), the panic is recovered and a minimal JSON fallback line
+is written instead, containing the timestamp, severity, raw message, and a `formatter_error` note:
+
+	{"formatter_error":"panic: boom","message":"User logged in","severity":"info","timestamp":"2024-01-02T03:04:05Z"}
+
+NewLogger wraps the configured (or default) formatter with SafeFormatter automatically.
+*/
+type SafeFormatter struct {
+	// Formatter is the wrapped formatter.
+	Formatter logrus.Formatter
+}
+
+// Format implements the logrus.Formatter interface.
+func (f *SafeFormatter) Format(entry *logrus.Entry) (serialized []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			serialized, err = fallbackFormat(entry, fmt.Sprintf("panic: %v", r))
+		}
+	}()
+
+	serialized, err = f.Formatter.Format(entry)
+	if err != nil {
+		return fallbackFormat(entry, err.Error())
+	}
+	return serialized, nil
+}
+
+// fallbackFormat writes a minimal JSON log line with the entry's timestamp, severity, message, and the formatter failure.
+func fallbackFormat(entry *logrus.Entry, formatterErr string) ([]byte, error) {
+	serialized, err := json.Marshal(map[string]string{
+		DefaultSJsonFmtTimestampKey: entry.Time.Format(time.RFC3339),
+		DefaultSJsonFmtSeverityKey:  entry.Level.String(),
+		DefaultSJsonFmtMessageKey:   entry.Message,
+		DefaultFormatterErrorKey:    formatterErr,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal fallback log line: %w", err)
+	}
+	return append(serialized, '\n'), nil
+}
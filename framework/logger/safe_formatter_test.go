@@ -0,0 +1,110 @@
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// panickingFormatter is a formatter that always panics.
+type panickingFormatter struct{}
+
+func (panickingFormatter) Format(*logrus.Entry) ([]byte, error) {
+	panic("boom")
+}
+
+// failingFormatter is a formatter that always returns an error.
+type failingFormatter struct{}
+
+func (failingFormatter) Format(*logrus.Entry) ([]byte, error) {
+	return nil, errors.New("cannot format")
+}
+
+func TestSafeFormatter_RecoversPanic(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:     logger.INFO,
+		Formatter: panickingFormatter{},
+		Output:    buffer,
+	})
+	require.NoError(t, err)
+
+	assert.NotPanics(t, func() {
+		log.Info(context.Background(), "User logged in", logger.Fields{"user_id": "123"})
+	})
+
+	var logEntry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &logEntry), "fallback line should be valid JSON")
+	assert.Equal(t, "info", logEntry[logger.DefaultSJsonFmtSeverityKey])
+	assert.Equal(t, "User logged in", logEntry[logger.DefaultSJsonFmtMessageKey])
+	assert.Equal(t, "panic: boom", logEntry[logger.DefaultFormatterErrorKey])
+	assert.NotEmpty(t, logEntry[logger.DefaultSJsonFmtTimestampKey])
+}
+
+func TestSafeFormatter_FormatterError(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:     logger.INFO,
+		Formatter: failingFormatter{},
+		Output:    buffer,
+	})
+	require.NoError(t, err)
+
+	log.Error(context.Background(), "Failed to process", errors.New("db down"), nil)
+
+	var logEntry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &logEntry), "fallback line should be valid JSON")
+	assert.Equal(t, "error", logEntry[logger.DefaultSJsonFmtSeverityKey])
+	assert.Equal(t, "Failed to process", logEntry[logger.DefaultSJsonFmtMessageKey])
+	assert.Equal(t, "cannot format", logEntry[logger.DefaultFormatterErrorKey])
+}
+
+func TestSafeFormatter_PanickingFieldKeyFormatter(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level: logger.INFO,
+		Formatter: &logger.StructuredJSONFormatter{
+			TimestampFormat: time.RFC3339,
+			FieldKeyFormatter: func(key string) string {
+				panic("bad key formatter")
+			},
+		},
+		Output: buffer,
+	})
+	require.NoError(t, err)
+
+	assert.NotPanics(t, func() {
+		log.Info(context.Background(), "Hello", nil)
+	})
+
+	var logEntry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &logEntry))
+	assert.Equal(t, "Hello", logEntry[logger.DefaultSJsonFmtMessageKey])
+	assert.Equal(t, "panic: bad key formatter", logEntry[logger.DefaultFormatterErrorKey])
+}
+
+func TestSafeFormatter_PassesThrough(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:     logger.INFO,
+		Formatter: &logger.SafeFormatter{Formatter: &logger.StructuredJSONFormatter{TimestampFormat: time.RFC3339}},
+		Output:    buffer,
+	})
+	require.NoError(t, err)
+
+	log.Info(context.Background(), "Hello", logger.Fields{"key": "value"})
+
+	var logEntry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &logEntry))
+	assert.Equal(t, "Hello", logEntry[logger.DefaultSJsonFmtMessageKey])
+	assert.Equal(t, "value", logEntry["key"])
+	assert.NotContains(t, logEntry, logger.DefaultFormatterErrorKey)
+}
@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// StrictFieldsPrefix is the prefix added to caller-provided fields that collide with a reserved key
+// when Config.StrictFields is enabled (e.g., "message" becomes "fields.message").
+const StrictFieldsPrefix = "fields."
+
+// reservedFieldKeys are the keys of the standard fields written by the formatters.
+var reservedFieldKeys = map[string]struct{}{
+	DefaultSJsonFmtTimestampKey:  {},
+	DefaultSJsonFmtSeverityKey:   {},
+	DefaultSJsonFmtMessageKey:    {},
+	DefaultSJsonFmtTraceIDKey:    {},
+	DefaultSJsonFmtSpanIDKey:     {},
+	DefaultSJsonFmtCallerKey:     {},
+	DefaultSJsonFmtStackTraceKey: {},
+}
+
+// strictFields renames fields colliding with reserved keys and remembers which keys have already been reported.
+// It is shared by a logger and the loggers derived from it with WithFields.
+type strictFields struct {
+	warned sync.Map // Reserved keys for which a warning has been logged.
+}
+
+// rename moves fields colliding with reserved keys to their prefixed key, and returns the keys that collided
+// for the first time.
+func (s *strictFields) rename(fields Fields) []string {
+	var firstCollisions []string
+	for key, value := range fields {
+		if _, reserved := reservedFieldKeys[key]; !reserved {
+			continue
+		}
+		delete(fields, key)
+		fields[StrictFieldsPrefix+key] = value
+		if _, warned := s.warned.LoadOrStore(key, struct{}{}); !warned {
+			firstCollisions = append(firstCollisions, key)
+		}
+	}
+	return firstCollisions
+}
+
+// warnCollisions logs a one-time warning for each reserved key used as a field.
+func (l *logger) warnCollisions(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		l.baselogger.WithContext(ctx).WithFields(logrus.Fields{
+			"field_key":   key,
+			"renamed_key": StrictFieldsPrefix + key,
+		}).Warn("Log field key collides with a reserved key and was renamed")
+	}
+}
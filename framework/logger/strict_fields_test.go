@@ -0,0 +1,107 @@
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// decodeLogEntries decodes every JSON log line written to the buffer.
+func decodeLogEntries(t *testing.T, buffer *bytes.Buffer) []map[string]interface{} {
+	var entries []map[string]interface{}
+	for _, line := range bytes.Split(bytes.TrimSpace(buffer.Bytes()), []byte("\n")) {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(line, &entry), "log entry should be valid JSON")
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestLogger_StrictFields(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:        logger.INFO,
+		Formatter:    &logger.StructuredJSONFormatter{TimestampFormat: time.RFC3339},
+		Output:       buffer,
+		StrictFields: true,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	log.Info(ctx, "User created", logger.Fields{"message": "hello", "user_id": 1})
+	log.WithFields(logger.Fields{"severity": "low"}).Info(ctx, "User updated", logger.Fields{"message": "again"})
+
+	entries := decodeLogEntries(t, buffer)
+	// Each colliding key is reported once, before the first entry using it.
+	require.Len(t, entries, 4)
+
+	assert.Equal(t, "warning", entries[0]["severity"])
+	assert.Equal(t, "message", entries[0]["field_key"])
+	assert.Equal(t, "fields.message", entries[0]["renamed_key"])
+
+	assert.Equal(t, "User created", entries[1]["message"])
+	assert.Equal(t, "info", entries[1]["severity"])
+	assert.Equal(t, "hello", entries[1]["fields.message"])
+	assert.Equal(t, float64(1), entries[1]["user_id"])
+
+	assert.Equal(t, "warning", entries[2]["severity"])
+	assert.Equal(t, "severity", entries[2]["field_key"])
+
+	assert.Equal(t, "User updated", entries[3]["message"])
+	assert.Equal(t, "info", entries[3]["severity"])
+	assert.Equal(t, "again", entries[3]["fields.message"])
+	assert.Equal(t, "low", entries[3]["fields.severity"])
+}
+
+func TestLogger_StrictFieldsWithTrace(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:        logger.INFO,
+		Formatter:    &logger.StructuredJSONFormatter{TimestampFormat: time.RFC3339},
+		Output:       buffer,
+		StrictFields: true,
+	})
+	require.NoError(t, err)
+
+	tracerProvider := sdktrace.NewTracerProvider()
+	defer func() { _ = tracerProvider.Shutdown(context.Background()) }()
+	ctx, span := tracerProvider.Tracer("test-tracer").Start(logger.NewContext(context.Background(), log), "test-span")
+	defer span.End()
+
+	// Log with a context that has no span, so the trace IDs can only come from the bound fields.
+	logger.FromContextWithTrace(ctx).Info(context.Background(), "User created", logger.Fields{"user_id": 1})
+
+	entries := decodeLogEntries(t, buffer)
+	// The trace keys are bound by the logger itself, so no collision warning is logged.
+	require.Len(t, entries, 1)
+	assert.Equal(t, "User created", entries[0]["message"])
+	assert.Equal(t, span.SpanContext().TraceID().String(), entries[0]["trace_id"])
+	assert.Equal(t, span.SpanContext().SpanID().String(), entries[0]["span_id"])
+	assert.NotContains(t, entries[0], "fields.trace_id")
+	assert.NotContains(t, entries[0], "fields.span_id")
+}
+
+func TestLogger_NonStrictFields(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:     logger.INFO,
+		Formatter: &logger.StructuredJSONFormatter{TimestampFormat: time.RFC3339},
+		Output:    buffer,
+	})
+	require.NoError(t, err)
+
+	log.Info(context.Background(), "User created", logger.Fields{"user_id": 1, "severity": "low"})
+
+	entries := decodeLogEntries(t, buffer)
+	require.Len(t, entries, 1)
+	// Current behavior: the standard field takes precedence and no renamed field is added.
+	assert.Equal(t, "info", entries[0]["severity"])
+	assert.NotContains(t, entries[0], "fields.severity")
+}
@@ -1,11 +1,14 @@
 package logger
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"runtime"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/kittipat1413/go-common/util/slice"
 	"github.com/sirupsen/logrus"
@@ -40,17 +43,26 @@ It includes the following fields:
   - trace_id: The trace ID if available.
   - span_id: The span ID if available.
   - caller: The caller's function name, file, and line number.
-  - stack_trace: The stack trace for error levels.
+  - stack_trace: The stack trace for error levels, unless the entry already has one from Logger.Panic (panic_stack).
 */
 type StructuredJSONFormatter struct {
 	// TimestampFormat sets the format used for marshaling timestamps.
 	TimestampFormat string
+	// TimeFunc returns the timestamp written for each log entry. Defaults to the time the entry was logged (time.Now).
+	// Set it to a fixed clock for deterministic output, e.g. in golden tests.
+	TimeFunc func() time.Time
 	// PrettyPrint will indent all JSON logs.
 	PrettyPrint bool
 	// SkipPackages is a list of packages to skip when searching for the caller.
 	SkipPackages []string
 	// FieldKeyFormatter is a function type that allows users to customize log field keys.
 	FieldKeyFormatter FieldKeyFormatter
+	// EnsureKeys is a list of top-level keys that are always emitted, with a null value if absent.
+	// Keys are matched after FieldKeyFormatter has been applied.
+	EnsureKeys []string
+	// KeyOrder is a list of top-level keys that are emitted first, in the given order.
+	// Remaining keys follow in alphabetical order. Keys are matched after FieldKeyFormatter has been applied.
+	KeyOrder []string
 }
 
 /*
@@ -95,7 +107,11 @@ func (f *StructuredJSONFormatter) Format(entry *logrus.Entry) ([]byte, error) {
 	}
 
 	// Add predefined keys with formatted keys.
-	data[f.FieldKeyFormatter(DefaultSJsonFmtTimestampKey)] = entry.Time.Format(f.TimestampFormat)
+	timestamp := entry.Time
+	if f.TimeFunc != nil {
+		timestamp = f.TimeFunc()
+	}
+	data[f.FieldKeyFormatter(DefaultSJsonFmtTimestampKey)] = timestamp.Format(f.TimestampFormat)
 	data[f.FieldKeyFormatter(DefaultSJsonFmtSeverityKey)] = entry.Level.String()
 	data[f.FieldKeyFormatter(DefaultSJsonFmtMessageKey)] = entry.Message
 
@@ -134,15 +150,30 @@ func (f *StructuredJSONFormatter) Format(entry *logrus.Entry) ([]byte, error) {
 		data[f.FieldKeyFormatter(DefaultSJsonFmtCallerKey)] = callerInfo
 	}
 
-	// Stack trace for error levels.
-	if entry.Level <= logrus.ErrorLevel {
+	// Stack trace for error levels, skipped if a panic stack was already captured.
+	if _, hasPanicStack := entry.Data[DefaultPanicStackKey]; entry.Level <= logrus.ErrorLevel && !hasPanicStack {
 		data[f.FieldKeyFormatter(DefaultSJsonFmtStackTraceKey)] = getStackTrace()
 	}
 
+	// Ensure required keys are present.
+	for _, key := range f.EnsureKeys {
+		if _, ok := data[key]; !ok {
+			data[key] = nil
+		}
+	}
+
 	// Serialize the data to JSON.
 	var serialized []byte
 	var err error
-	if f.PrettyPrint {
+	if len(f.KeyOrder) > 0 {
+		serialized, err = marshalOrdered(data, f.KeyOrder)
+		if err == nil && f.PrettyPrint {
+			var indented bytes.Buffer
+			if err = json.Indent(&indented, serialized, "", "  "); err == nil {
+				serialized = indented.Bytes()
+			}
+		}
+	} else if f.PrettyPrint {
 		serialized, err = json.MarshalIndent(data, "", "  ")
 	} else {
 		serialized, err = json.Marshal(data)
@@ -153,6 +184,52 @@ func (f *StructuredJSONFormatter) Format(entry *logrus.Entry) ([]byte, error) {
 	return append(serialized, '\n'), nil
 }
 
+// marshalOrdered serializes data as a JSON object, writing the keys in keyOrder first (in that order)
+// followed by the remaining keys in alphabetical order.
+func marshalOrdered(data logrus.Fields, keyOrder []string) ([]byte, error) {
+	keys := make([]string, 0, len(data))
+	seen := make(map[string]struct{}, len(data))
+	for _, key := range keyOrder {
+		if _, ok := data[key]; !ok {
+			continue
+		}
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	remaining := make([]string, 0, len(data)-len(keys))
+	for key := range data {
+		if _, ok := seen[key]; !ok {
+			remaining = append(remaining, key)
+		}
+	}
+	sort.Strings(remaining)
+	keys = append(keys, remaining...)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		encodedValue, err := json.Marshal(data[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encodedKey)
+		buf.WriteByte(':')
+		buf.Write(encodedValue)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
 // extractTraceIDs retrieves the trace and span IDs from the context.
 func extractTraceIDs(ctx context.Context) (*string, *string) {
 	span := trace.SpanFromContext(ctx)
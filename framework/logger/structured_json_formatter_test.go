@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -114,3 +115,90 @@ func TestStructuredJSONFormatter_WithTraceAndSpanIDs(t *testing.T) {
 	assert.Equal(t, "Info message with trace and span IDs", logEntry["message"], "message should match")
 	assert.Equal(t, "info", logEntry["severity"], "severity should match")
 }
+
+func TestStructuredJSONFormatter_EnsureKeys(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	log, err := logger.NewLogger(logger.Config{
+		Level: logger.INFO,
+		Formatter: &logger.StructuredJSONFormatter{
+			TimestampFormat: time.RFC3339,
+			EnsureKeys:      []string{logger.DefaultServiceNameKey, "request_id"},
+		},
+		Output: buffer,
+	})
+	assert.NoError(t, err)
+
+	log.Info(context.Background(), "Info message", logger.Fields{"request_id": "abc"})
+
+	var logEntry map[string]interface{}
+	err = json.Unmarshal(buffer.Bytes(), &logEntry)
+	assert.NoError(t, err, "log entry should be valid JSON")
+
+	assert.Contains(t, logEntry, "service_name", "ensured key should be present even when not set")
+	assert.Nil(t, logEntry["service_name"], "absent ensured key should be null")
+	assert.Equal(t, "abc", logEntry["request_id"], "ensured key that is set should keep its value")
+}
+
+func TestStructuredJSONFormatter_KeyOrder(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	log, err := logger.NewLogger(logger.Config{
+		Level: logger.INFO,
+		Formatter: &logger.StructuredJSONFormatter{
+			TimestampFormat: time.RFC3339,
+			KeyOrder: []string{
+				logger.DefaultSJsonFmtTimestampKey,
+				logger.DefaultSJsonFmtSeverityKey,
+				logger.DefaultServiceNameKey,
+				logger.DefaultSJsonFmtMessageKey,
+			},
+			EnsureKeys: []string{logger.DefaultServiceNameKey},
+		},
+		Output: buffer,
+	})
+	assert.NoError(t, err)
+
+	log.Info(context.Background(), "Info message", logger.Fields{"b_key": 1, "a_key": 2})
+
+	// Decode the keys in the order they appear in the output.
+	decoder := json.NewDecoder(bytes.NewReader(buffer.Bytes()))
+	_, err = decoder.Token() // opening brace
+	assert.NoError(t, err)
+	var keys []string
+	for decoder.More() {
+		token, err := decoder.Token()
+		assert.NoError(t, err)
+		keys = append(keys, token.(string))
+		var value json.RawMessage
+		assert.NoError(t, decoder.Decode(&value))
+	}
+
+	assert.GreaterOrEqual(t, len(keys), 6, "log should contain ordered and remaining keys")
+	assert.Equal(t, []string{"timestamp", "severity", "service_name", "message"}, keys[:4], "configured keys should come first in order")
+	remaining := keys[4:]
+	assert.Equal(t, "a_key", remaining[0], "remaining keys should be sorted alphabetically")
+	assert.Equal(t, "b_key", remaining[1], "remaining keys should be sorted alphabetically")
+	assert.True(t, sort.StringsAreSorted(remaining), "remaining keys should be sorted alphabetically")
+}
+
+func TestStructuredJSONFormatter_TimeFunc(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	fixedTime := time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	log, err := logger.NewLogger(logger.Config{
+		Level: logger.INFO,
+		Formatter: &logger.StructuredJSONFormatter{
+			TimestampFormat: time.RFC3339,
+			TimeFunc:        func() time.Time { return fixedTime },
+		},
+		Output: buffer,
+	})
+	assert.NoError(t, err)
+
+	log.Info(context.Background(), "Info message", nil)
+
+	var logEntry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buffer.Bytes(), &logEntry))
+	assert.Equal(t, "2024-01-02T03:04:05Z", logEntry[logger.DefaultSJsonFmtTimestampKey])
+}
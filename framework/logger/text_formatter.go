@@ -0,0 +1,116 @@
+package logger
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kittipat1413/go-common/util/slice"
+	"github.com/sirupsen/logrus"
+)
+
+/*
+TextFormatter is a custom logrus formatter for human-readable, single-line logs, intended for local development.
+Each entry is written as:
+
+	<timestamp> <LEVEL> <message> key=value ... error="..." trace_id=... span_id=... caller=<file>:<line>
+
+Custom fields are sorted by key so output is stable between runs.
+For error levels, the stack trace is written on the lines following the entry, unless the entry already
+has one from Logger.Panic (panic_stack).
+*/
+type TextFormatter struct {
+	// TimestampFormat sets the format used for timestamps. Defaults to time.RFC3339 if empty.
+	TimestampFormat string
+	// SkipPackages is a list of packages to skip when searching for the caller.
+	SkipPackages []string
+	// DisableStackTrace disables writing the stack trace for error levels.
+	DisableStackTrace bool
+}
+
+// Format implements the logrus.Formatter interface.
+func (f *TextFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	timestampFormat := f.TimestampFormat
+	if timestampFormat == "" {
+		timestampFormat = time.RFC3339
+	}
+
+	b := &bytes.Buffer{}
+	b.WriteString(entry.Time.Format(timestampFormat))
+	b.WriteByte(' ')
+	fmt.Fprintf(b, "%-5s", strings.ToUpper(entry.Level.String()))
+	b.WriteByte(' ')
+	b.WriteString(entry.Message)
+
+	// Write custom fields sorted by key, skipping the error, trace, and span keys which are written afterward.
+	keys := make([]string, 0, len(entry.Data))
+	for key := range entry.Data {
+		switch key {
+		case DefaultErrorKey, DefaultSJsonFmtTraceIDKey, DefaultSJsonFmtSpanIDKey:
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		writeTextField(b, key, entry.Data[key])
+	}
+
+	// Include error message if present.
+	if err, ok := entry.Data[DefaultErrorKey]; ok {
+		writeTextField(b, DefaultErrorKey, err)
+	}
+
+	// Include trace and span IDs if available. IDs bound as fields (e.g., by FromContextWithTrace)
+	// take precedence over the active span in the context, so each key is written once.
+	var traceID, spanID *string
+	if entry.Context != nil {
+		traceID, spanID = extractTraceIDs(entry.Context)
+	}
+	if value, ok := entry.Data[DefaultSJsonFmtTraceIDKey]; ok {
+		writeTextField(b, DefaultSJsonFmtTraceIDKey, value)
+	} else if traceID != nil {
+		writeTextField(b, DefaultSJsonFmtTraceIDKey, *traceID)
+	}
+	if value, ok := entry.Data[DefaultSJsonFmtSpanIDKey]; ok {
+		writeTextField(b, DefaultSJsonFmtSpanIDKey, value)
+	} else if spanID != nil {
+		writeTextField(b, DefaultSJsonFmtSpanIDKey, *spanID)
+	}
+
+	// Caller's file and line number.
+	skipPackages := slice.Union(f.SkipPackages, defaultSJsonFmtSkipPackages)
+	if _, file, line := getCaller(skipPackages); file != "" && line != 0 {
+		writeTextField(b, DefaultSJsonFmtCallerKey, fmt.Sprintf("%s:%d", file, line))
+	}
+	b.WriteByte('\n')
+
+	// Stack trace for error levels, skipped if a panic stack was already captured.
+	if _, hasPanicStack := entry.Data[DefaultPanicStackKey]; !f.DisableStackTrace && entry.Level <= logrus.ErrorLevel && !hasPanicStack {
+		b.WriteString(getStackTrace())
+	}
+	return b.Bytes(), nil
+}
+
+// writeTextField writes a key=value pair, quoting the value if it contains spaces, quotes, or is empty.
+func writeTextField(b *bytes.Buffer, key string, value interface{}) {
+	var s string
+	switch v := value.(type) {
+	case error:
+		s = v.Error()
+	case string:
+		s = v
+	default:
+		s = fmt.Sprintf("%v", v)
+	}
+	b.WriteByte(' ')
+	b.WriteString(key)
+	b.WriteByte('=')
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		b.WriteString(fmt.Sprintf("%q", s))
+	} else {
+		b.WriteString(s)
+	}
+}
@@ -0,0 +1,139 @@
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestTextFormatter(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level: logger.INFO,
+		Formatter: &logger.TextFormatter{
+			TimestampFormat: time.RFC3339,
+		},
+		Output: buffer,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	log.Info(ctx, "Info message", logger.Fields{"b_key": "value with spaces", "a_key": 42})
+
+	line := strings.TrimSuffix(buffer.String(), "\n")
+	assert.NotContains(t, line, "\n", "info log should be a single line")
+	assert.Contains(t, line, " INFO  Info message a_key=42 b_key=\"value with spaces\"", "fields should be sorted and quoted")
+	assert.Contains(t, line, "caller=", "log should include the caller")
+	assert.Error(t, json.Unmarshal(buffer.Bytes(), &map[string]interface{}{}), "log should not be JSON")
+
+	buffer.Reset()
+	log.Error(ctx, "Error message", errors.New("test error"), nil)
+
+	lines := strings.SplitN(buffer.String(), "\n", 2)
+	assert.Contains(t, lines[0], " ERROR Error message error=\"test error\"", "error should be included")
+	assert.Contains(t, lines[1], "goroutine", "stack trace should follow error logs")
+}
+
+func TestTextFormatter_TraceIDsInFieldsAndContext(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:     logger.INFO,
+		Formatter: &logger.TextFormatter{TimestampFormat: time.RFC3339},
+		Output:    buffer,
+	})
+	require.NoError(t, err)
+
+	tracerProvider := sdktrace.NewTracerProvider()
+	defer func() { _ = tracerProvider.Shutdown(context.Background()) }()
+	ctx, span := tracerProvider.Tracer("test-tracer").Start(logger.NewContext(context.Background(), log), "test-span")
+	defer span.End()
+
+	// The trace IDs are both bound as fields and available from the span in the logging context.
+	logger.FromContextWithTrace(ctx).Info(ctx, "Info message", logger.Fields{"a_key": 42})
+
+	line := strings.TrimSuffix(buffer.String(), "\n")
+	assert.Equal(t, 1, strings.Count(line, " trace_id="), "trace_id should be written once")
+	assert.Equal(t, 1, strings.Count(line, " span_id="), "span_id should be written once")
+	assert.Contains(t, line, " INFO  Info message a_key=42 trace_id="+span.SpanContext().TraceID().String()+
+		" span_id="+span.SpanContext().SpanID().String()+" caller=")
+}
+
+func TestTextFormatter_Panic(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level:     logger.INFO,
+		Formatter: &logger.TextFormatter{TimestampFormat: time.RFC3339},
+		Output:    buffer,
+	})
+	require.NoError(t, err)
+
+	log.Panic(context.Background(), "something went wrong", nil)
+
+	// The panic stack is written as a field, so no stack trace follows the entry.
+	lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], " ERROR Panic recovered: something went wrong")
+	assert.Contains(t, lines[0], " panic_stack=")
+}
+
+func TestNewLogger_EnvironmentDefaultFormatter(t *testing.T) {
+	tests := []struct {
+		name        string
+		environment string
+		expectJSON  bool
+	}{
+		{name: "local environment uses text", environment: "local", expectJSON: false},
+		{name: "development environment uses text", environment: "Development", expectJSON: false},
+		{name: "production environment uses JSON", environment: "production", expectJSON: true},
+		{name: "empty environment uses JSON", environment: "", expectJSON: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			log, err := logger.NewLogger(logger.Config{
+				Level:       logger.INFO,
+				Environment: tt.environment,
+				Output:      buffer,
+			})
+			require.NoError(t, err)
+
+			log.Info(context.Background(), "Info message", nil)
+
+			var logEntry map[string]interface{}
+			err = json.Unmarshal(buffer.Bytes(), &logEntry)
+			if tt.expectJSON {
+				assert.NoError(t, err, "log entry should be valid JSON")
+				assert.Equal(t, "Info message", logEntry["message"])
+			} else {
+				assert.Error(t, err, "log entry should not be JSON")
+				assert.Contains(t, buffer.String(), "INFO  Info message")
+			}
+		})
+	}
+
+	t.Run("explicit formatter takes precedence", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		log, err := logger.NewLogger(logger.Config{
+			Level:       logger.INFO,
+			Environment: "local",
+			Formatter:   &logger.StructuredJSONFormatter{TimestampFormat: time.RFC3339},
+			Output:      buffer,
+		})
+		require.NoError(t, err)
+
+		log.Info(context.Background(), "Info message", nil)
+
+		var logEntry map[string]interface{}
+		assert.NoError(t, json.Unmarshal(buffer.Bytes(), &logEntry), "log entry should be valid JSON")
+	})
+}
@@ -0,0 +1,40 @@
+package logger
+
+import (
+	"context"
+	"time"
+)
+
+/*
+Timer logs the start of an operation at Debug level and returns a function that, when called,
+logs its completion at Info level with the elapsed time in milliseconds under the `duration_ms` field,
+merged with the provided fields. If l is nil, the logger is retrieved from the context.
+
+Example:
+
+	done := logger.Timer(ctx, log, "Fetching user")
+	user, err := repo.GetUser(ctx, id)
+	done(logger.Fields{"user_id": id})
+	// Debug: "Fetching user started"
+	// Info:  "Fetching user completed" {"duration_ms": 12.3, "user_id": "123"}
+*/
+func Timer(ctx context.Context, l Logger, msg string) func(fields Fields) {
+	if l == nil {
+		l = FromContext(ctx)
+	}
+
+	start := time.Now()
+	l.Debug(ctx, msg+" started", nil)
+
+	return func(fields Fields) {
+		elapsed := time.Since(start)
+
+		completionFields := make(Fields, len(fields)+1)
+		for k, v := range fields {
+			completionFields[k] = v
+		}
+		completionFields[DefaultDurationMsKey] = float64(elapsed) / float64(time.Millisecond)
+
+		l.Info(ctx, msg+" completed", completionFields)
+	}
+}
@@ -0,0 +1,72 @@
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimer(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level: logger.DEBUG,
+		Formatter: &logger.StructuredJSONFormatter{
+			TimestampFormat: time.RFC3339,
+		},
+		Output: buffer,
+	})
+	require.NoError(t, err)
+
+	done := logger.Timer(context.Background(), log, "Fetching user")
+	time.Sleep(5 * time.Millisecond)
+	done(logger.Fields{"user_id": "123"})
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	require.Len(t, lines, 2, "should log a start and a completion entry")
+
+	var startEntry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &startEntry))
+	assert.Equal(t, "debug", startEntry["severity"])
+	assert.Equal(t, "Fetching user started", startEntry["message"])
+	assert.NotContains(t, startEntry, logger.DefaultDurationMsKey)
+
+	var doneEntry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &doneEntry))
+	assert.Equal(t, "info", doneEntry["severity"])
+	assert.Equal(t, "Fetching user completed", doneEntry["message"])
+	assert.Equal(t, "123", doneEntry["user_id"])
+	duration, ok := doneEntry[logger.DefaultDurationMsKey].(float64)
+	require.True(t, ok, "duration_ms should be a number")
+	assert.GreaterOrEqual(t, duration, float64(5))
+}
+
+func TestTimer_NilFieldsAndLoggerFromContext(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	log, err := logger.NewLogger(logger.Config{
+		Level: logger.INFO,
+		Formatter: &logger.StructuredJSONFormatter{
+			TimestampFormat: time.RFC3339,
+		},
+		Output: buffer,
+	})
+	require.NoError(t, err)
+	ctx := logger.NewContext(context.Background(), log)
+
+	done := logger.Timer(ctx, nil, "Processing")
+	done(nil)
+
+	// The start entry is below the configured level, so only the completion entry is logged.
+	var doneEntry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &doneEntry))
+	assert.Equal(t, "Processing completed", doneEntry["message"])
+	duration, ok := doneEntry[logger.DefaultDurationMsKey].(float64)
+	require.True(t, ok, "duration_ms should be a number")
+	assert.Greater(t, duration, float64(0))
+}
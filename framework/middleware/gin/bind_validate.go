@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+// validatedBodyKey is the key used to store the validated request body in the Gin context.
+const validatedBodyKey = "go-common/validated-body"
+
+// GetValidatedBody retrieves the request body validated by BindAndValidate from the Gin context.
+// It returns false if no body was stored or if the stored body is not of type T.
+func GetValidatedBody[T any](c *gin.Context) (*T, bool) {
+	value, exists := c.Get(validatedBodyKey)
+	if !exists {
+		return nil, false
+	}
+	body, ok := value.(*T)
+	return body, ok
+}
+
+// BindAndValidate returns a Gin middleware that binds the JSON request body into a new T and validates it
+// using the provided validator.
+//
+// The middleware performs the following tasks:
+//  1. Binds the JSON request body into a value of type T. If binding fails, the request is aborted with a
+//     400 Bad Request response built from a BadRequestError.
+//  2. Validates the bound value using `ValidateStructDetailed`. If validation fails, the request is aborted with a
+//     422 Unprocessable Entity response built from an UnprocessableEntityError, whose data lists each failing field.
+//  3. Stores the validated value in the Gin context, making it accessible to downstream handlers via `GetValidatedBody`.
+//
+// The error responses share the `{code, message, data}` body of BindJSON.
+//
+// Validation failure response body:
+//
+//	{
+//		"code": "SVC-404000",
+//		"message": "Validation failed",
+//		"data": [
+//			{"field": "email", "tag": "email", "message": "email must be a valid email address"}
+//		]
+//	}
+//
+// Example Usage:
+//
+//	v, _ := validator.NewValidator(validator.WithTagNameFunc(validator.JSONTagNameFunc))
+//	router.POST("/users", BindAndValidate[CreateUserRequest](v), func(c *gin.Context) {
+//		req, _ := GetValidatedBody[CreateUserRequest](c)
+//		// use req
+//	})
+func BindAndValidate[T any](v *validator.Validator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		body := new(T)
+		if err := c.ShouldBindJSON(body); err != nil {
+			_ = abortWithDomainError(c, domain_error.NewBadRequestError("Invalid request body", nil))
+			return
+		}
+
+		if err := v.ValidateStructDetailed(body); err != nil {
+			var ve validator.ValidationErrors
+			if errors.As(err, &ve) {
+				_ = abortWithDomainError(c, domain_error.NewUnprocessableEntityError("Validation failed", ve))
+				return
+			}
+			_ = abortWithDomainError(c, domain_error.NewBadRequestError(err.Error(), nil))
+			return
+		}
+
+		c.Set(validatedBodyKey, body)
+		c.Next()
+	}
+}
+
+// BindJSON binds the JSON request body into target and validates it using the provided validator.
+// It is a helper for use inside handlers, where BindAndValidate cannot be used (e.g., when the target type is only known at runtime).
+//
+// On failure, it aborts the request with a BadRequestError response and returns the error:
+//   - If the body cannot be bound, the error has no data.
+//   - If validation fails, the error data is a map of field path to translated message.
+//
+// Error response body:
+//
+//	{
+//		"code": "SVC-401000",
+//		"message": "Validation failed",
+//		"data": {
+//			"email": "email must be a valid email address",
+//			"name": "name is a required field"
+//		}
+//	}
+//
+// Example Usage:
+//
+//	router.POST("/users", func(c *gin.Context) {
+//		var req CreateUserRequest
+//		if err := BindJSON(c, v, &req); err != nil {
+//			return // The response has already been written.
+//		}
+//		// use req
+//	})
+func BindJSON(c *gin.Context, v *validator.Validator, target interface{}) error {
+	if err := c.ShouldBindJSON(target); err != nil {
+		return abortWithDomainError(c, domain_error.NewBadRequestError("Invalid request body", nil))
+	}
+
+	fieldErrs, err := v.ValidateStructToMap(target)
+	if err != nil {
+		return abortWithDomainError(c, domain_error.NewBadRequestError(err.Error(), nil))
+	}
+	if len(fieldErrs) > 0 {
+		return abortWithDomainError(c, domain_error.NewBadRequestError("Validation failed", fieldErrs))
+	}
+	return nil
+}
+
+// abortWithDomainError aborts the request with a JSON response built from the domain error's
+// HTTP status, code, message, and data, and returns the error.
+// If err is not a DomainError, a 500 Internal Server Error response is written.
+func abortWithDomainError(c *gin.Context, err error) error {
+	domainErr := domain_error.UnwrapDomainError(err)
+	if domainErr == nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return err
+	}
+	c.AbortWithStatusJSON(domainErr.GetHTTPCode(), gin.H{
+		"code":    domainErr.Code(),
+		"message": domainErr.GetMessage(),
+		"data":    domainErr.GetData(),
+	})
+	return err
+}
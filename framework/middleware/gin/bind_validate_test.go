@@ -0,0 +1,152 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+	middleware "github.com/kittipat1413/go-common/framework/middleware/gin"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type createUserRequest struct {
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email" validate:"required,email"`
+	Age   int    `json:"age" validate:"gte=0,lte=130"`
+}
+
+func setupBindAndValidateRouter(t *testing.T) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	v, err := validator.NewValidator(validator.WithTagNameFunc(validator.JSONTagNameFunc))
+	require.NoError(t, err)
+
+	router.POST("/users", middleware.BindAndValidate[createUserRequest](v), func(c *gin.Context) {
+		req, ok := middleware.GetValidatedBody[createUserRequest](c)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "No validated body"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"name": req.Name})
+	})
+	return router
+}
+
+func TestBindAndValidate_ValidBody(t *testing.T) {
+	router := setupBindAndValidateRouter(t)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/users", strings.NewReader(`{"name":"Alice","email":"alice@example.com","age":30}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"name": "Alice"}`, w.Body.String())
+}
+
+func TestBindAndValidate_InvalidBody(t *testing.T) {
+	router := setupBindAndValidateRouter(t)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/users", strings.NewReader(`{"email":"not-an-email","age":150}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.JSONEq(t, `{
+		"code": "`+domain_error.GetFullCode(domain_error.StatusCodeGenericUnprocessableEntityError)+`",
+		"message": "Validation failed",
+		"data": [
+			{"field": "name", "tag": "required", "message": "name is a required field"},
+			{"field": "email", "tag": "email", "message": "email must be a valid email address"},
+			{"field": "age", "tag": "lte", "param": "130", "message": "age must be 130 or less"}
+		]
+	}`, w.Body.String())
+}
+
+func TestBindAndValidate_MalformedJSON(t *testing.T) {
+	router := setupBindAndValidateRouter(t)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/users", strings.NewReader(`{"name":`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{
+		"code": "`+domain_error.GetFullCode(domain_error.StatusCodeGenericBadRequestError)+`",
+		"message": "Invalid request body",
+		"data": null
+	}`, w.Body.String())
+}
+
+func setupBindJSONRouter(t *testing.T) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	v, err := validator.NewValidator(validator.WithTagNameFunc(validator.JSONTagNameFunc))
+	require.NoError(t, err)
+
+	router.POST("/users", func(c *gin.Context) {
+		var req createUserRequest
+		if err := middleware.BindJSON(c, v, &req); err != nil {
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"name": req.Name})
+	})
+	return router
+}
+
+func TestBindJSON_Success(t *testing.T) {
+	router := setupBindJSONRouter(t)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/users", strings.NewReader(`{"name":"Alice","email":"alice@example.com","age":30}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"name": "Alice"}`, w.Body.String())
+}
+
+func TestBindJSON_ValidationFailure(t *testing.T) {
+	router := setupBindJSONRouter(t)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/users", strings.NewReader(`{"email":"not-an-email","age":150}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{
+		"code": "`+domain_error.GetFullCode(domain_error.StatusCodeGenericBadRequestError)+`",
+		"message": "Validation failed",
+		"data": {
+			"name": "name is a required field",
+			"email": "email must be a valid email address",
+			"age": "age must be 130 or less"
+		}
+	}`, w.Body.String())
+}
+
+func TestBindJSON_MalformedJSON(t *testing.T) {
+	router := setupBindJSONRouter(t)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/users", strings.NewReader(`{"name":`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{
+		"code": "`+domain_error.GetFullCode(domain_error.StatusCodeGenericBadRequestError)+`",
+		"message": "Invalid request body",
+		"data": null
+	}`, w.Body.String())
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+)
+
+// RequireContentType returns a Gin middleware that rejects POST, PUT, and PATCH requests whose `Content-Type`
+// is not one of the allowed media types (e.g., "application/json"). If no types are given, only "application/json" is allowed.
+//
+// The middleware performs the following tasks:
+//  1. Skips requests with other methods (e.g., GET, DELETE) and requests without a body.
+//  2. Compares the media type of the `Content-Type` header with the allowed types, case-insensitively and
+//     ignoring parameters such as `charset`.
+//  3. If it is missing or not allowed, aborts the request with a 415 Unsupported Media Type response whose body
+//     is built from a BadRequestError.
+//
+// Error response body:
+//
+//	{
+//		"code": "SVC-401000",
+//		"message": "Unsupported content type",
+//		"data": {
+//			"content_type": "text/plain",
+//			"allowed": ["application/json"]
+//		}
+//	}
+//
+// Example Usage:
+//
+//	router.Use(RequireContentType("application/json"))
+func RequireContentType(types ...string) gin.HandlerFunc {
+	if len(types) == 0 {
+		types = []string{gin.MIMEJSON}
+	}
+	allowed := make(map[string]struct{}, len(types))
+	for _, t := range types {
+		allowed[normalizeMediaType(t)] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+		default:
+			c.Next()
+			return
+		}
+		if c.Request.ContentLength == 0 {
+			c.Next()
+			return
+		}
+
+		contentType := c.GetHeader("Content-Type")
+		if _, ok := allowed[normalizeMediaType(contentType)]; ok {
+			c.Next()
+			return
+		}
+
+		domainErr := domain_error.UnwrapDomainError(domain_error.NewBadRequestError("Unsupported content type", map[string]interface{}{
+			"content_type": contentType,
+			"allowed":      types,
+		}))
+		if domainErr == nil {
+			c.AbortWithStatusJSON(http.StatusUnsupportedMediaType, gin.H{"error": "Unsupported content type"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusUnsupportedMediaType, gin.H{
+			"code":    domainErr.Code(),
+			"message": domainErr.GetMessage(),
+			"data":    domainErr.GetData(),
+		})
+	}
+}
+
+// normalizeMediaType returns the lower-cased media type of a Content-Type value, without parameters.
+func normalizeMediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		// Fall back to stripping the parameters manually for malformed values.
+		mediaType, _, _ = strings.Cut(contentType, ";")
+	}
+	return strings.ToLower(strings.TrimSpace(mediaType))
+}
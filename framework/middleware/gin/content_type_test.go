@@ -0,0 +1,79 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+	middleware "github.com/kittipat1413/go-common/framework/middleware/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireContentType(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.RequireContentType("application/json", "application/merge-patch+json"))
+	handler := func(c *gin.Context) { c.Status(http.StatusNoContent) }
+	router.GET("/users", handler)
+	router.POST("/users", handler)
+	router.PATCH("/users", handler)
+	router.DELETE("/users", handler)
+
+	tests := []struct {
+		name           string
+		method         string
+		contentType    string
+		body           string
+		expectedStatus int
+	}{
+		{name: "POST with application/json", method: "POST", contentType: "application/json", body: `{}`, expectedStatus: http.StatusNoContent},
+		{name: "POST with charset parameter", method: "POST", contentType: "application/json; charset=utf-8", body: `{}`, expectedStatus: http.StatusNoContent},
+		{name: "POST with different case", method: "POST", contentType: "Application/JSON", body: `{}`, expectedStatus: http.StatusNoContent},
+		{name: "PATCH with another allowed type", method: "PATCH", contentType: "application/merge-patch+json", body: `{}`, expectedStatus: http.StatusNoContent},
+		{name: "POST with text/plain", method: "POST", contentType: "text/plain", body: `hello`, expectedStatus: http.StatusUnsupportedMediaType},
+		{name: "POST without content type", method: "POST", body: `{}`, expectedStatus: http.StatusUnsupportedMediaType},
+		{name: "POST without body", method: "POST", expectedStatus: http.StatusNoContent},
+		{name: "GET is skipped", method: "GET", contentType: "text/plain", expectedStatus: http.StatusNoContent},
+		{name: "DELETE is skipped", method: "DELETE", contentType: "text/plain", body: `hello`, expectedStatus: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/users", strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRequireContentType_ErrorResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/users", middleware.RequireContentType(), func(c *gin.Context) {
+		t.Error("handler should not be called")
+	})
+
+	req := httptest.NewRequest("POST", "/users", strings.NewReader("hello"))
+	req.Header.Set("Content-Type", "text/plain")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, domain_error.GetFullCode(domain_error.StatusCodeGenericBadRequestError), body["code"])
+	assert.Equal(t, "Unsupported content type", body["message"])
+	assert.Equal(t, map[string]interface{}{
+		"content_type": "text/plain",
+		"allowed":      []interface{}{"application/json"},
+	}, body["data"])
+}
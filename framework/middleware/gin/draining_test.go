@@ -0,0 +1,127 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	middleware "github.com/kittipat1413/go-common/framework/middleware/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupDrainingRouter(drainer *middleware.Drainer, started chan<- struct{}, release <-chan struct{}) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(drainer.Middleware())
+	router.GET("/slow", func(c *gin.Context) {
+		close(started)
+		<-release
+		c.String(http.StatusOK, "done")
+	})
+	router.GET("/fast", func(c *gin.Context) {
+		c.String(http.StatusOK, "done")
+	})
+	return router
+}
+
+func TestDraining_NotDraining(t *testing.T) {
+	drainer := middleware.NewDrainer()
+	router := setupDrainingRouter(drainer, nil, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/fast", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "done", w.Body.String())
+	assert.False(t, drainer.IsDraining())
+	assert.Equal(t, int64(0), drainer.Inflight())
+}
+
+func TestDraining_RejectsNewRequestsWhileInflightCompletes(t *testing.T) {
+	drainer := middleware.NewDrainer()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	router := setupDrainingRouter(drainer, started, release)
+
+	// Start a request before draining begins.
+	slow := httptest.NewRecorder()
+	slowDone := make(chan struct{})
+	go func() {
+		defer close(slowDone)
+		router.ServeHTTP(slow, httptest.NewRequest("GET", "/slow", nil))
+	}()
+	<-started
+	assert.Equal(t, int64(1), drainer.Inflight())
+
+	drainer.StartDraining()
+	assert.True(t, drainer.IsDraining())
+
+	// New requests are rejected.
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/fast", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "close", w.Header().Get("Connection"))
+	assert.JSONEq(t, `{"error": "Service is shutting down. Please try again later."}`, w.Body.String())
+
+	// The in-flight request is still running.
+	err := drainer.Wait(20 * time.Millisecond)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, middleware.ErrInflightTimeout))
+
+	// The in-flight request completes.
+	close(release)
+	require.NoError(t, drainer.Wait(time.Second))
+	<-slowDone
+	assert.Equal(t, http.StatusOK, slow.Code)
+	assert.Equal(t, "done", slow.Body.String())
+	assert.Equal(t, int64(0), drainer.Inflight())
+}
+
+func TestDraining_IndependentDrainers(t *testing.T) {
+	busy := middleware.NewDrainer()
+	idle := middleware.NewDrainer()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	busyRouter := setupDrainingRouter(busy, started, release)
+	idleRouter := setupDrainingRouter(idle, nil, nil)
+
+	slowDone := make(chan struct{})
+	go func() {
+		defer close(slowDone)
+		busyRouter.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/slow", nil))
+	}()
+	<-started
+	defer func() {
+		close(release)
+		<-slowDone
+	}()
+
+	// The idle drainer does not wait for the busy drainer's request.
+	assert.Equal(t, int64(1), busy.Inflight())
+	assert.Equal(t, int64(0), idle.Inflight())
+	start := time.Now()
+	require.NoError(t, idle.Wait(time.Second))
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+
+	// Draining one drainer does not affect the other.
+	idle.StartDraining()
+	w := httptest.NewRecorder()
+	idleRouter.ServeHTTP(w, httptest.NewRequest("GET", "/fast", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	w = httptest.NewRecorder()
+	busyRouter.ServeHTTP(w, httptest.NewRequest("GET", "/fast", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.False(t, busy.IsDraining())
+}
+
+func TestDrainer_WaitNoRequests(t *testing.T) {
+	start := time.Now()
+	require.NoError(t, middleware.NewDrainer().Wait(time.Second))
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
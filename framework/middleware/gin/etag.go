@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// etagOptions holds configuration options for the ETag middleware.
+type etagOptions struct {
+	weak bool // Whether to generate weak ETags (W/"...").
+}
+
+// ETagOption is a function that configures etagOptions.
+type ETagOption func(*etagOptions)
+
+// WithWeakETag generates weak ETags (e.g., W/"5d41402a"), indicating that responses with the same ETag are
+// semantically equivalent rather than byte-for-byte identical (e.g., when a proxy may compress them).
+func WithWeakETag() ETagOption {
+	return func(opts *etagOptions) {
+		opts.weak = true
+	}
+}
+
+// ETag returns a Gin middleware that adds an ETag header to successful responses to GET requests and answers
+// conditional requests with `304 Not Modified`, saving the bandwidth of resending an unchanged body.
+//
+// The middleware performs the following tasks:
+//  1. Buffers the response written by the handler chain instead of sending it.
+//  2. For a 200 OK response, computes the ETag from a SHA-256 hash of the body, unless the handler already set one.
+//  3. If the request's `If-None-Match` header matches the ETag (or is "*"), responds with 304 Not Modified and no body.
+//     Otherwise, writes the buffered response with the ETag header.
+//
+// Requests with other methods and responses with other status codes are passed through unchanged.
+// Since the response is buffered, do not use the middleware on streaming routes (e.g., server-sent events).
+//
+// Example Usage:
+//
+//	router.GET("/products", ETag(), productsHandler)
+func ETag(opts ...ETagOption) gin.HandlerFunc {
+	// Set default options.
+	options := &etagOptions{}
+
+	// Apply any user-provided options.
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		writer := &etagResponseWriter{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = writer
+		// Restore the writer even if a handler panics, so that a recovery middleware can write its response.
+		defer func() { c.Writer = original }()
+		c.Next()
+
+		if writer.status != http.StatusOK {
+			writer.flush()
+			return
+		}
+
+		etag := original.Header().Get("ETag")
+		if etag == "" {
+			sum := sha256.Sum256(writer.body.Bytes())
+			etag = `"` + hex.EncodeToString(sum[:16]) + `"`
+			if options.weak {
+				etag = "W/" + etag
+			}
+			original.Header().Set("ETag", etag)
+		}
+
+		if etagMatches(c.GetHeader("If-None-Match"), etag) {
+			original.Header().Del("Content-Length")
+			original.WriteHeader(http.StatusNotModified)
+			original.WriteHeaderNow()
+			return
+		}
+		writer.flush()
+	}
+}
+
+// etagMatches reports whether an If-None-Match header value matches the ETag, using the weak comparison
+// required for If-None-Match (the W/ prefix is ignored).
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// etagResponseWriter wraps gin.ResponseWriter to buffer the status and body until the ETag is computed.
+type etagResponseWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *etagResponseWriter) WriteHeader(code int) {
+	if code > 0 {
+		w.status = code
+	}
+}
+
+// WriteHeaderNow is a no-op; the status is written by flush.
+func (w *etagResponseWriter) WriteHeaderNow() {}
+
+func (w *etagResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *etagResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *etagResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *etagResponseWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *etagResponseWriter) Written() bool {
+	return w.body.Len() > 0
+}
+
+// Flush is a no-op; the response is buffered until the handler chain completes.
+func (w *etagResponseWriter) Flush() {}
+
+// flush writes the buffered status and body to the underlying writer.
+func (w *etagResponseWriter) flush() {
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.WriteHeaderNow()
+	if w.body.Len() > 0 {
+		_, _ = w.ResponseWriter.Write(w.body.Bytes())
+	}
+}
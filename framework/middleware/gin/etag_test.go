@@ -0,0 +1,128 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	middleware "github.com/kittipat1413/go-common/framework/middleware/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupETagRouter(opts ...middleware.ETagOption) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.ETag(opts...))
+	router.GET("/products", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"products": []string{"apple", "banana"}})
+	})
+	router.GET("/missing", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+	})
+	router.GET("/versioned", func(c *gin.Context) {
+		c.Header("ETag", `"v42"`)
+		c.String(http.StatusOK, "versioned")
+	})
+	router.POST("/products", func(c *gin.Context) {
+		c.String(http.StatusCreated, "created")
+	})
+	return router
+}
+
+func TestETag_ConditionalRequest(t *testing.T) {
+	router := setupETagRouter()
+
+	first := httptest.NewRecorder()
+	router.ServeHTTP(first, httptest.NewRequest("GET", "/products", nil))
+	require.Equal(t, http.StatusOK, first.Code)
+	assert.JSONEq(t, `{"products": ["apple", "banana"]}`, first.Body.String())
+	assert.Equal(t, "application/json; charset=utf-8", first.Header().Get("Content-Type"))
+	etag := first.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.True(t, strings.HasPrefix(etag, `"`) && strings.HasSuffix(etag, `"`), "ETag should be a quoted strong ETag")
+
+	// The ETag is stable for the same body.
+	second := httptest.NewRecorder()
+	router.ServeHTTP(second, httptest.NewRequest("GET", "/products", nil))
+	assert.Equal(t, etag, second.Header().Get("ETag"))
+
+	for _, ifNoneMatch := range []string{etag, `"other", ` + etag, "W/" + etag, "*"} {
+		conditional := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/products", nil)
+		req.Header.Set("If-None-Match", ifNoneMatch)
+		router.ServeHTTP(conditional, req)
+		assert.Equal(t, http.StatusNotModified, conditional.Code, "If-None-Match: %s", ifNoneMatch)
+		assert.Empty(t, conditional.Body.String())
+		assert.Equal(t, etag, conditional.Header().Get("ETag"))
+	}
+
+	stale := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/products", nil)
+	req.Header.Set("If-None-Match", `"stale"`)
+	router.ServeHTTP(stale, req)
+	assert.Equal(t, http.StatusOK, stale.Code)
+	assert.JSONEq(t, `{"products": ["apple", "banana"]}`, stale.Body.String())
+}
+
+func TestETag_WeakETag(t *testing.T) {
+	router := setupETagRouter(middleware.WithWeakETag())
+
+	first := httptest.NewRecorder()
+	router.ServeHTTP(first, httptest.NewRequest("GET", "/products", nil))
+	etag := first.Header().Get("ETag")
+	require.True(t, strings.HasPrefix(etag, `W/"`), "ETag should be weak")
+
+	conditional := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/products", nil)
+	req.Header.Set("If-None-Match", etag)
+	router.ServeHTTP(conditional, req)
+	assert.Equal(t, http.StatusNotModified, conditional.Code)
+}
+
+func TestETag_HandlerETag(t *testing.T) {
+	router := setupETagRouter()
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/versioned", nil)
+	req.Header.Set("If-None-Match", `"v42"`)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Equal(t, `"v42"`, w.Header().Get("ETag"))
+}
+
+func TestETag_SkipsNonCacheableResponses(t *testing.T) {
+	router := setupETagRouter()
+
+	notFound := httptest.NewRecorder()
+	router.ServeHTTP(notFound, httptest.NewRequest("GET", "/missing", nil))
+	assert.Equal(t, http.StatusNotFound, notFound.Code)
+	assert.JSONEq(t, `{"error": "not found"}`, notFound.Body.String())
+	assert.Empty(t, notFound.Header().Get("ETag"))
+
+	created := httptest.NewRecorder()
+	router.ServeHTTP(created, httptest.NewRequest("POST", "/products", nil))
+	assert.Equal(t, http.StatusCreated, created.Code)
+	assert.Equal(t, "created", created.Body.String())
+	assert.Empty(t, created.Header().Get("ETag"))
+}
+
+func TestETag_Panic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(gin.CustomRecovery(func(c *gin.Context, recovered interface{}) {
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}))
+	router.Use(middleware.ETag())
+	router.GET("/panic", func(c *gin.Context) {
+		c.String(http.StatusOK, "partial")
+		panic("boom")
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/panic", nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Empty(t, w.Body.String())
+}
@@ -0,0 +1,154 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	common_logger "github.com/kittipat1413/go-common/framework/logger"
+)
+
+// DefaultShutdownTimeout is the default maximum duration for draining the server and running cleanups.
+const DefaultShutdownTimeout = 10 * time.Second
+
+// shutdownCleanup is a named cleanup function run after the server has stopped.
+type shutdownCleanup struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// shutdownOptions holds configuration options for RunWithGracefulShutdown.
+type shutdownOptions struct {
+	timeout  time.Duration        // Maximum duration for draining the server and running cleanups.
+	signals  []os.Signal          // Signals that trigger the shutdown.
+	cleanups []shutdownCleanup    // Cleanups run in registration order after the server has stopped.
+	logger   common_logger.Logger // logger is the custom logger to use. If nil, the logger will be retrieved from the context.
+}
+
+// ShutdownOption is a function that configures shutdownOptions.
+type ShutdownOption func(*shutdownOptions)
+
+// WithShutdownTimeout sets the maximum duration for draining in-flight requests and running cleanups.
+func WithShutdownTimeout(timeout time.Duration) ShutdownOption {
+	return func(opts *shutdownOptions) {
+		if timeout > 0 {
+			opts.timeout = timeout
+		}
+	}
+}
+
+// WithShutdownSignals sets the OS signals that trigger the shutdown (default: SIGINT and SIGTERM).
+func WithShutdownSignals(signals ...os.Signal) ShutdownOption {
+	return func(opts *shutdownOptions) {
+		if len(signals) > 0 {
+			opts.signals = signals
+		}
+	}
+}
+
+// WithShutdownCleanup registers a named cleanup function (e.g., tracer shutdown, logger flush).
+// Cleanups run in registration order after the server has stopped, sharing the shutdown timeout.
+func WithShutdownCleanup(name string, cleanup func(ctx context.Context) error) ShutdownOption {
+	return func(opts *shutdownOptions) {
+		if cleanup != nil {
+			opts.cleanups = append(opts.cleanups, shutdownCleanup{name: name, fn: cleanup})
+		}
+	}
+}
+
+// WithShutdownLogger sets a custom logger for RunWithGracefulShutdown.
+func WithShutdownLogger(logger common_logger.Logger) ShutdownOption {
+	return func(opts *shutdownOptions) {
+		opts.logger = logger
+	}
+}
+
+// RunWithGracefulShutdown starts the HTTP server and blocks until it is shut down.
+//
+// The helper performs the following tasks:
+//  1. Starts the server with `ListenAndServe` in the background.
+//  2. Waits for a shutdown signal (default: SIGINT, SIGTERM), the cancellation of ctx, or a server error.
+//  3. Drains in-flight requests with `srv.Shutdown`, bounded by the shutdown timeout (default: 10s).
+//     If draining does not complete in time, the server is closed forcefully.
+//  4. Runs the registered cleanups in order (e.g., tracer shutdown, logger flush), even if draining failed.
+//
+// It returns nil on a clean shutdown, or the joined errors of the server, draining, and cleanups.
+//
+// Example Usage:
+//
+//	srv := &http.Server{Addr: ":8080", Handler: router}
+//	err := RunWithGracefulShutdown(ctx, srv,
+//		WithShutdownTimeout(5*time.Second),
+//		WithShutdownCleanup("tracer", tracerProvider.Shutdown),
+//	)
+func RunWithGracefulShutdown(ctx context.Context, srv *http.Server, opts ...ShutdownOption) error {
+	// Set default options.
+	options := &shutdownOptions{
+		timeout: DefaultShutdownTimeout,
+		signals: []os.Signal{syscall.SIGINT, syscall.SIGTERM},
+	}
+
+	// Apply any user-provided options.
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	logger := options.logger
+	if logger == nil {
+		logger = common_logger.FromContext(ctx)
+	}
+
+	// Listen for OS signals.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, options.signals...)
+	defer signal.Stop(quit)
+
+	// Start the server.
+	serverErrors := make(chan error, 1)
+	go func() {
+		logger.Info(ctx, "Starting HTTP server", common_logger.Fields{"addr": srv.Addr})
+		serverErrors <- srv.ListenAndServe()
+	}()
+
+	var errs []error
+	select {
+	case err := <-serverErrors:
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(ctx, "Server error", err, nil)
+			errs = append(errs, fmt.Errorf("server error: %w", err))
+		}
+	case sig := <-quit:
+		logger.Info(ctx, "Shutting down server", common_logger.Fields{"signal": sig.String()})
+	case <-ctx.Done():
+		logger.Info(ctx, "Shutting down server", common_logger.Fields{"reason": ctx.Err().Error()})
+	}
+
+	// Drain in-flight requests, bounded by the shutdown timeout.
+	// A fresh context is used so that draining is not cut short by the cancellation of ctx.
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), options.timeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.Error(ctx, "Server shutdown error", err, nil)
+		errs = append(errs, fmt.Errorf("server shutdown failed: %w", err))
+		_ = srv.Close()
+	}
+
+	// Run cleanups in registration order.
+	for _, cleanup := range options.cleanups {
+		if err := cleanup.fn(shutdownCtx); err != nil {
+			logger.Error(ctx, "Shutdown cleanup error", err, common_logger.Fields{"cleanup": cleanup.name})
+			errs = append(errs, fmt.Errorf("cleanup %q failed: %w", cleanup.name, err))
+		}
+	}
+
+	if len(errs) == 0 {
+		logger.Info(ctx, "Server exited properly", nil)
+	}
+	return errors.Join(errs...)
+}
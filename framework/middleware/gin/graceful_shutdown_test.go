@@ -0,0 +1,206 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	common_logger "github.com/kittipat1413/go-common/framework/logger"
+	middleware "github.com/kittipat1413/go-common/framework/middleware/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// freeAddr returns a local address with a free TCP port.
+func freeAddr(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return addr
+}
+
+// waitForServer blocks until the server at addr accepts connections.
+func waitForServer(t *testing.T, addr string) {
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+// runServer starts RunWithGracefulShutdown in the background and returns a channel receiving its result.
+func runServer(ctx context.Context, srv *http.Server, opts ...middleware.ShutdownOption) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		opts = append([]middleware.ShutdownOption{middleware.WithShutdownLogger(common_logger.NewNoopLogger())}, opts...)
+		done <- middleware.RunWithGracefulShutdown(ctx, srv, opts...)
+	}()
+	return done
+}
+
+func TestRunWithGracefulShutdown_Signal(t *testing.T) {
+	srv := &http.Server{Addr: freeAddr(t), Handler: http.NewServeMux()}
+
+	var mu sync.Mutex
+	var order []string
+	cleanup := func(name string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, name)
+			return nil
+		}
+	}
+
+	done := runServer(context.Background(), srv,
+		middleware.WithShutdownSignals(syscall.SIGHUP),
+		middleware.WithShutdownTimeout(time.Second),
+		middleware.WithShutdownCleanup("tracer", cleanup("tracer")),
+		middleware.WithShutdownCleanup("logger", cleanup("logger")),
+	)
+	waitForServer(t, srv.Addr)
+
+	// Simulate the signal.
+	process, err := os.FindProcess(os.Getpid())
+	require.NoError(t, err)
+	require.NoError(t, process.Signal(syscall.SIGHUP))
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("server did not stop within the timeout")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"tracer", "logger"}, order)
+
+	_, err = net.Dial("tcp", srv.Addr)
+	assert.Error(t, err, "server should no longer accept connections")
+}
+
+func TestRunWithGracefulShutdown_ContextCanceled(t *testing.T) {
+	srv := &http.Server{Addr: freeAddr(t), Handler: http.NewServeMux()}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cleanupCalled := false
+	done := runServer(ctx, srv,
+		middleware.WithShutdownCleanup("flush", func(ctx context.Context) error {
+			cleanupCalled = true
+			return nil
+		}),
+	)
+	waitForServer(t, srv.Addr)
+
+	cancel()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("server did not stop within the timeout")
+	}
+	assert.True(t, cleanupCalled)
+}
+
+func TestRunWithGracefulShutdown_DrainTimeout(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})
+	srv := &http.Server{Addr: freeAddr(t), Handler: mux}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cleanupCalled := false
+	done := runServer(ctx, srv,
+		middleware.WithShutdownTimeout(50*time.Millisecond),
+		middleware.WithShutdownCleanup("flush", func(ctx context.Context) error {
+			cleanupCalled = true
+			return nil
+		}),
+	)
+	waitForServer(t, srv.Addr)
+
+	go func() {
+		resp, err := http.Get("http://" + srv.Addr + "/slow")
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+	<-started
+
+	start := time.Now()
+	cancel()
+
+	select {
+	case err := <-done:
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Less(t, time.Since(start), time.Second)
+	case <-time.After(2 * time.Second):
+		t.Fatal("server did not stop within the timeout")
+	}
+	assert.True(t, cleanupCalled, "cleanups should run even if draining fails")
+}
+
+func TestRunWithGracefulShutdown_CleanupError(t *testing.T) {
+	srv := &http.Server{Addr: freeAddr(t), Handler: http.NewServeMux()}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	secondCalled := false
+	done := runServer(ctx, srv,
+		middleware.WithShutdownCleanup("tracer", func(ctx context.Context) error {
+			return errors.New("exporter unavailable")
+		}),
+		middleware.WithShutdownCleanup("logger", func(ctx context.Context) error {
+			secondCalled = true
+			return nil
+		}),
+	)
+	waitForServer(t, srv.Addr)
+
+	cancel()
+
+	select {
+	case err := <-done:
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cleanup "tracer" failed: exporter unavailable`)
+	case <-time.After(2 * time.Second):
+		t.Fatal("server did not stop within the timeout")
+	}
+	assert.True(t, secondCalled, "later cleanups should run even if an earlier one fails")
+}
+
+func TestRunWithGracefulShutdown_ServerError(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	// The address is already in use, so ListenAndServe fails immediately.
+	srv := &http.Server{Addr: listener.Addr().String(), Handler: http.NewServeMux()}
+	done := runServer(context.Background(), srv)
+
+	select {
+	case err := <-done:
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "server error")
+	case <-time.After(2 * time.Second):
+		t.Fatal("RunWithGracefulShutdown did not return on server error")
+	}
+}
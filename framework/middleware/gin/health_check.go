@@ -0,0 +1,179 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Health statuses reported by the HealthCheck handlers.
+const (
+	HealthStatusOK          = "ok"
+	HealthStatusError       = "error"
+	HealthStatusUnavailable = "unavailable"
+)
+
+// DefaultHealthCheckTimeout is the default maximum duration for running all readiness checks.
+const DefaultHealthCheckTimeout = 5 * time.Second
+
+// Check is a named readiness check (e.g., a database or SFTP ping).
+// It should return a non-nil error if the dependency is not ready.
+type Check struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// CheckResult is the result of a single readiness check.
+type CheckResult struct {
+	Status string `json:"status"`          // HealthStatusOK or HealthStatusError.
+	Error  string `json:"error,omitempty"` // The error message if the check failed.
+}
+
+// HealthResponse is the JSON body returned by the HealthCheck handlers.
+type HealthResponse struct {
+	Status string                 `json:"status"`           // HealthStatusOK or HealthStatusUnavailable.
+	Checks map[string]CheckResult `json:"checks,omitempty"` // Per-check results (readiness only).
+}
+
+// healthOptions holds configuration options for the HealthCheck handlers.
+type healthOptions struct {
+	checks  []Check       // Checks to run for readiness.
+	timeout time.Duration // Maximum duration for running all checks.
+}
+
+// HealthOption is a function that configures healthOptions.
+type HealthOption func(*healthOptions)
+
+// WithHealthCheck registers a named readiness check.
+func WithHealthCheck(name string, check func(ctx context.Context) error) HealthOption {
+	return func(opts *healthOptions) {
+		if check != nil {
+			opts.checks = append(opts.checks, Check{Name: name, Check: check})
+		}
+	}
+}
+
+// WithHealthChecks registers one or more readiness checks.
+func WithHealthChecks(checks ...Check) HealthOption {
+	return func(opts *healthOptions) {
+		for _, check := range checks {
+			if check.Check != nil {
+				opts.checks = append(opts.checks, check)
+			}
+		}
+	}
+}
+
+// WithHealthCheckTimeout sets the maximum duration for running all readiness checks.
+func WithHealthCheckTimeout(timeout time.Duration) HealthOption {
+	return func(opts *healthOptions) {
+		if timeout > 0 {
+			opts.timeout = timeout
+		}
+	}
+}
+
+// HealthCheck returns Gin handlers for liveness and readiness endpoints.
+//
+// The liveness handler always responds with 200 OK, indicating that the process is running.
+// The readiness handler runs all registered checks concurrently (bounded by the configured timeout) and
+// responds with 200 OK if all checks pass, or 503 Service Unavailable with a per-check status map if any fail.
+//
+// Readiness response body:
+//
+//	{
+//		"status": "unavailable",
+//		"checks": {
+//			"database": {"status": "ok"},
+//			"sftp": {"status": "error", "error": "connection refused"}
+//		}
+//	}
+//
+// Example Usage:
+//
+//	liveness, readiness := HealthCheck(
+//		WithHealthCheck("database", db.PingContext),
+//		WithHealthCheckTimeout(2*time.Second),
+//	)
+//	router.GET("/health", liveness)
+//	router.GET("/ready", readiness)
+func HealthCheck(opts ...HealthOption) (liveness gin.HandlerFunc, readiness gin.HandlerFunc) {
+	// Set default options.
+	options := &healthOptions{
+		timeout: DefaultHealthCheckTimeout,
+	}
+
+	// Apply any user-provided options.
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	liveness = func(c *gin.Context) {
+		c.JSON(http.StatusOK, HealthResponse{Status: HealthStatusOK})
+	}
+
+	readiness = func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), options.timeout)
+		defer cancel()
+
+		results := runHealthChecks(ctx, options.checks)
+
+		response := HealthResponse{Status: HealthStatusOK, Checks: results}
+		for _, result := range results {
+			if result.Status != HealthStatusOK {
+				response.Status = HealthStatusUnavailable
+				break
+			}
+		}
+
+		if response.Status != HealthStatusOK {
+			c.JSON(http.StatusServiceUnavailable, response)
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+
+	return liveness, readiness
+}
+
+// runHealthChecks runs the checks concurrently and collects their results.
+// A check that does not complete before the context is done is reported as failed with the context error.
+func runHealthChecks(ctx context.Context, checks []Check) map[string]CheckResult {
+	results := make(map[string]CheckResult, len(checks))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, check := range checks {
+		wg.Add(1)
+		go func(check Check) {
+			defer wg.Done()
+
+			done := make(chan error, 1)
+			go func() {
+				done <- check.Check(ctx)
+			}()
+
+			var err error
+			select {
+			case err = <-done:
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+
+			result := CheckResult{Status: HealthStatusOK}
+			if err != nil {
+				result = CheckResult{Status: HealthStatusError, Error: err.Error()}
+			}
+
+			mu.Lock()
+			results[check.Name] = result
+			mu.Unlock()
+		}(check)
+	}
+
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,107 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	middleware "github.com/kittipat1413/go-common/framework/middleware/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupHealthRouter(opts ...middleware.HealthOption) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	liveness, readiness := middleware.HealthCheck(opts...)
+	router.GET("/health", liveness)
+	router.GET("/ready", readiness)
+	return router
+}
+
+func TestHealthCheck_Liveness(t *testing.T) {
+	router := setupHealthRouter(
+		middleware.WithHealthCheck("database", func(ctx context.Context) error {
+			return errors.New("should not be called")
+		}),
+	)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/health", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status": "ok"}`, w.Body.String())
+}
+
+func TestHealthCheck_ReadinessAllPassing(t *testing.T) {
+	router := setupHealthRouter(
+		middleware.WithHealthCheck("database", func(ctx context.Context) error { return nil }),
+		middleware.WithHealthChecks(middleware.Check{
+			Name:  "sftp",
+			Check: func(ctx context.Context) error { return nil },
+		}),
+	)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/ready", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{
+		"status": "ok",
+		"checks": {
+			"database": {"status": "ok"},
+			"sftp": {"status": "ok"}
+		}
+	}`, w.Body.String())
+}
+
+func TestHealthCheck_ReadinessFailingCheck(t *testing.T) {
+	router := setupHealthRouter(
+		middleware.WithHealthCheck("database", func(ctx context.Context) error { return nil }),
+		middleware.WithHealthCheck("sftp", func(ctx context.Context) error {
+			return errors.New("connection refused")
+		}),
+	)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/ready", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.JSONEq(t, `{
+		"status": "unavailable",
+		"checks": {
+			"database": {"status": "ok"},
+			"sftp": {"status": "error", "error": "connection refused"}
+		}
+	}`, w.Body.String())
+}
+
+func TestHealthCheck_ReadinessTimeout(t *testing.T) {
+	router := setupHealthRouter(
+		middleware.WithHealthCheckTimeout(20*time.Millisecond),
+		middleware.WithHealthCheck("slow", func(ctx context.Context) error {
+			time.Sleep(time.Second)
+			return nil
+		}),
+	)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/ready", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.JSONEq(t, `{
+		"status": "unavailable",
+		"checks": {
+			"slow": {"status": "error", "error": "context deadline exceeded"}
+		}
+	}`, w.Body.String())
+}
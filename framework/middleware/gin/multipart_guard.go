@@ -0,0 +1,212 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+)
+
+// multipartFormOverhead is the allowance added to the maximum total size when limiting the request body,
+// for the multipart boundaries, the part headers, and the non-file form fields.
+const multipartFormOverhead int64 = 1 << 20
+
+// multipartOptions holds configuration options for the MultipartGuard middleware.
+type multipartOptions struct {
+	maxFiles            int                 // Maximum number of files per request (0 means no limit).
+	maxFileSize         int64               // Maximum size of a single file in bytes (0 means no limit).
+	maxTotalSize        int64               // Maximum combined size of all files in bytes (0 means no limit).
+	allowedContentTypes map[string]struct{} // Allowed file media types (empty means any).
+	allowedExtensions   map[string]struct{} // Allowed file name extensions, lower-cased with a leading dot (empty means any).
+}
+
+// MultipartOption is a function that configures multipartOptions.
+type MultipartOption func(*multipartOptions)
+
+// WithMultipartMaxFiles sets the maximum number of files allowed in a request, across all form fields.
+func WithMultipartMaxFiles(n int) MultipartOption {
+	return func(opts *multipartOptions) {
+		opts.maxFiles = n
+	}
+}
+
+// WithMultipartMaxFileSize sets the maximum size, in bytes, of each uploaded file.
+func WithMultipartMaxFileSize(size int64) MultipartOption {
+	return func(opts *multipartOptions) {
+		opts.maxFileSize = size
+	}
+}
+
+// WithMultipartMaxTotalSize sets the maximum combined size, in bytes, of all uploaded files in a request.
+func WithMultipartMaxTotalSize(size int64) MultipartOption {
+	return func(opts *multipartOptions) {
+		opts.maxTotalSize = size
+	}
+}
+
+// WithMultipartAllowedContentTypes sets the media types (e.g., "image/png") allowed for uploaded files,
+// compared case-insensitively and ignoring parameters.
+func WithMultipartAllowedContentTypes(types ...string) MultipartOption {
+	return func(opts *multipartOptions) {
+		for _, t := range types {
+			opts.allowedContentTypes[normalizeMediaType(t)] = struct{}{}
+		}
+	}
+}
+
+// WithMultipartAllowedExtensions sets the file name extensions (e.g., ".png" or "png") allowed for uploaded files,
+// compared case-insensitively.
+func WithMultipartAllowedExtensions(extensions ...string) MultipartOption {
+	return func(opts *multipartOptions) {
+		for _, ext := range extensions {
+			ext = strings.ToLower(strings.TrimSpace(ext))
+			if !strings.HasPrefix(ext, ".") {
+				ext = "." + ext
+			}
+			opts.allowedExtensions[ext] = struct{}{}
+		}
+	}
+}
+
+// MultipartGuard returns a Gin middleware that validates the files of `multipart/form-data` requests before the
+// handler runs, centralizing upload validation. All limits are disabled unless configured.
+//
+// The middleware performs the following tasks:
+//  1. Skips requests whose `Content-Type` is not `multipart/form-data`.
+//  2. Parses the multipart form (using the engine's MaxMultipartMemory); the parsed form stays available to the
+//     handler via `c.MultipartForm()` or `c.FormFile()`. If a maximum total size is configured, the request body is
+//     limited to that size plus an allowance for the multipart encoding (1 MiB), so parsing stops as soon as the
+//     limit is exceeded instead of reading the whole upload.
+//  3. Checks the number of files, the total size, and, for each file, its size, its `Content-Type`, and its
+//     file name extension. When both content types and extensions are configured, a file must match both.
+//  4. On violation (or if the form cannot be parsed), aborts the request with a 400 Bad Request response whose
+//     body is built from a BadRequestError.
+//
+// Error response body:
+//
+//	{
+//		"code": "SVC-401000",
+//		"message": "File is too large",
+//		"data": {
+//			"field": "avatar",
+//			"filename": "photo.png",
+//			"size": 10485760,
+//			"max_size": 5242880
+//		}
+//	}
+//
+// Example Usage:
+//
+//	router.POST("/avatars", MultipartGuard(
+//		WithMultipartMaxFiles(1),
+//		WithMultipartMaxFileSize(5<<20),
+//		WithMultipartAllowedContentTypes("image/png", "image/jpeg"),
+//	), uploadHandler)
+func MultipartGuard(opts ...MultipartOption) gin.HandlerFunc {
+	// Set default options.
+	options := &multipartOptions{
+		allowedContentTypes: make(map[string]struct{}),
+		allowedExtensions:   make(map[string]struct{}),
+	}
+
+	// Apply any user-provided options.
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return func(c *gin.Context) {
+		if normalizeMediaType(c.GetHeader("Content-Type")) != gin.MIMEMultipartPOSTForm {
+			c.Next()
+			return
+		}
+
+		if options.maxTotalSize > 0 {
+			c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, options.maxTotalSize+multipartFormOverhead)
+		}
+
+		form, err := c.MultipartForm()
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				_ = abortWithDomainError(c, domain_error.NewBadRequestError("Total upload size is too large", map[string]interface{}{
+					"max_total_size": options.maxTotalSize,
+				}))
+				return
+			}
+			_ = abortWithDomainError(c, domain_error.NewBadRequestError("Invalid multipart form", nil))
+			return
+		}
+
+		// Sort the fields so the reported violation is deterministic.
+		fields := make([]string, 0, len(form.File))
+		fileCount := 0
+		for field, files := range form.File {
+			fields = append(fields, field)
+			fileCount += len(files)
+		}
+		sort.Strings(fields)
+
+		if options.maxFiles > 0 && fileCount > options.maxFiles {
+			_ = abortWithDomainError(c, domain_error.NewBadRequestError("Too many files", map[string]interface{}{
+				"files":     fileCount,
+				"max_files": options.maxFiles,
+			}))
+			return
+		}
+
+		var totalSize int64
+		for _, field := range fields {
+			for _, file := range form.File[field] {
+				if options.maxFileSize > 0 && file.Size > options.maxFileSize {
+					_ = abortWithDomainError(c, domain_error.NewBadRequestError("File is too large", map[string]interface{}{
+						"field":    field,
+						"filename": file.Filename,
+						"size":     file.Size,
+						"max_size": options.maxFileSize,
+					}))
+					return
+				}
+
+				if len(options.allowedContentTypes) > 0 {
+					contentType := file.Header.Get("Content-Type")
+					if _, ok := options.allowedContentTypes[normalizeMediaType(contentType)]; !ok {
+						_ = abortWithDomainError(c, domain_error.NewBadRequestError("Unsupported file content type", map[string]interface{}{
+							"field":        field,
+							"filename":     file.Filename,
+							"content_type": contentType,
+						}))
+						return
+					}
+				}
+
+				if len(options.allowedExtensions) > 0 {
+					extension := strings.ToLower(filepath.Ext(file.Filename))
+					if _, ok := options.allowedExtensions[extension]; !ok {
+						_ = abortWithDomainError(c, domain_error.NewBadRequestError("Unsupported file extension", map[string]interface{}{
+							"field":     field,
+							"filename":  file.Filename,
+							"extension": extension,
+						}))
+						return
+					}
+				}
+
+				totalSize += file.Size
+			}
+		}
+
+		if options.maxTotalSize > 0 && totalSize > options.maxTotalSize {
+			_ = abortWithDomainError(c, domain_error.NewBadRequestError("Total upload size is too large", map[string]interface{}{
+				"total_size":     totalSize,
+				"max_total_size": options.maxTotalSize,
+			}))
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,182 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+	middleware "github.com/kittipat1413/go-common/framework/middleware/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type multipartFile struct {
+	field       string
+	filename    string
+	contentType string
+	content     string
+}
+
+func newMultipartRequest(t *testing.T, files ...multipartFile) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	require.NoError(t, writer.WriteField("description", "upload"))
+	for _, file := range files {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", `form-data; name="`+file.field+`"; filename="`+file.filename+`"`)
+		header.Set("Content-Type", file.contentType)
+		part, err := writer.CreatePart(header)
+		require.NoError(t, err)
+		_, err = part.Write([]byte(file.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/upload", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestMultipartGuard(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/upload", middleware.MultipartGuard(
+		middleware.WithMultipartMaxFiles(2),
+		middleware.WithMultipartMaxFileSize(10),
+		middleware.WithMultipartMaxTotalSize(15),
+		middleware.WithMultipartAllowedContentTypes("image/png", "image/jpeg"),
+		middleware.WithMultipartAllowedExtensions(".png", "JPG"),
+	), func(c *gin.Context) {
+		form, err := c.MultipartForm()
+		require.NoError(t, err)
+		c.JSON(http.StatusOK, gin.H{"files": len(form.File["file"])})
+	})
+
+	png := func(name, content string) multipartFile {
+		return multipartFile{field: "file", filename: name, contentType: "image/png", content: content}
+	}
+
+	tests := []struct {
+		name            string
+		files           []multipartFile
+		expectedStatus  int
+		expectedMessage string
+	}{
+		{name: "valid files", files: []multipartFile{png("a.png", "12345"), {field: "file", filename: "b.JPG", contentType: "image/jpeg", content: "12345"}}, expectedStatus: http.StatusOK},
+		{name: "no files", expectedStatus: http.StatusOK},
+		{name: "too many files", files: []multipartFile{png("a.png", "1"), png("b.png", "2"), png("c.png", "3")}, expectedStatus: http.StatusBadRequest, expectedMessage: "Too many files"},
+		{name: "file too large", files: []multipartFile{png("a.png", "12345678901")}, expectedStatus: http.StatusBadRequest, expectedMessage: "File is too large"},
+		{name: "total size too large", files: []multipartFile{png("a.png", "12345678"), png("b.png", "12345678")}, expectedStatus: http.StatusBadRequest, expectedMessage: "Total upload size is too large"},
+		{name: "content type not allowed", files: []multipartFile{{field: "file", filename: "a.png", contentType: "application/x-msdownload", content: "1"}}, expectedStatus: http.StatusBadRequest, expectedMessage: "Unsupported file content type"},
+		{name: "extension not allowed", files: []multipartFile{png("a.exe", "1")}, expectedStatus: http.StatusBadRequest, expectedMessage: "Unsupported file extension"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, newMultipartRequest(t, tt.files...))
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedMessage != "" {
+				var body map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				assert.Equal(t, domain_error.GetFullCode(domain_error.StatusCodeGenericBadRequestError), body["code"])
+				assert.Equal(t, tt.expectedMessage, body["message"])
+			}
+		})
+	}
+}
+
+func TestMultipartGuard_ViolationData(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handlerCalled := false
+	router.POST("/upload", middleware.MultipartGuard(middleware.WithMultipartMaxFileSize(3)), func(c *gin.Context) {
+		handlerCalled = true
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newMultipartRequest(t, multipartFile{field: "avatar", filename: "photo.png", contentType: "image/png", content: "1234"}))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.False(t, handlerCalled)
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, map[string]interface{}{
+		"field":    "avatar",
+		"filename": "photo.png",
+		"size":     float64(4),
+		"max_size": float64(3),
+	}, body["data"])
+}
+
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	reader io.Reader
+	read   int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	return n, err
+}
+
+func TestMultipartGuard_OversizedBodyStopsParsing(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handlerCalled := false
+	router.POST("/upload", middleware.MultipartGuard(middleware.WithMultipartMaxTotalSize(1024)), func(c *gin.Context) {
+		handlerCalled = true
+	})
+
+	// The body is far larger than the limit plus the 1 MiB allowance for the multipart encoding.
+	const limit = 1024 + 1<<20
+	req := newMultipartRequest(t, multipartFile{field: "file", filename: "big.bin", contentType: "application/octet-stream", content: strings.Repeat("x", 8<<20)})
+	body := &countingReader{reader: req.Body}
+	req.Body = io.NopCloser(body)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.False(t, handlerCalled)
+	assert.LessOrEqual(t, body.read, int64(limit+1), "parsing should stop at the limit")
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "Total upload size is too large", response["message"])
+	assert.Equal(t, map[string]interface{}{"max_total_size": float64(1024)}, response["data"])
+}
+
+func TestMultipartGuard_Skipped(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/upload", middleware.MultipartGuard(middleware.WithMultipartMaxFiles(1)), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	// Non-multipart requests pass through.
+	req := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	// Malformed multipart bodies are rejected.
+	req = httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader("not a multipart body"))
+	req.Header.Set("Content-Type", "multipart/form-data; boundary=xyz")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid multipart form")
+}
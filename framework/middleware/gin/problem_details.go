@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+)
+
+// AbortWithProblemDetails aborts the request with a problem details (RFC 7807) response built from the error
+// with errors.ToProblemDetails, using the request path as the instance, and returns the error.
+// The response has the `application/problem+json` content type.
+//
+// Error response body:
+//
+//	{
+//		"type": "about:blank",
+//		"title": "Not Found",
+//		"status": 404,
+//		"detail": "user not found",
+//		"instance": "/users/42",
+//		"code": "SVC-402000"
+//	}
+//
+// Example Usage:
+//
+//	router.GET("/users/:id", func(c *gin.Context) {
+//		user, err := service.GetUser(c.Request.Context(), c.Param("id"))
+//		if err != nil {
+//			_ = AbortWithProblemDetails(c, err)
+//			return
+//		}
+//		c.JSON(http.StatusOK, user)
+//	})
+func AbortWithProblemDetails(c *gin.Context, err error) error {
+	problem := domain_error.ToProblemDetails(err, c.Request.URL.Path)
+	c.Header("Content-Type", domain_error.ProblemDetailsContentType)
+	c.AbortWithStatusJSON(problem.Status, problem)
+	return err
+}
@@ -0,0 +1,37 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	domain_error "github.com/kittipat1413/go-common/framework/errors"
+	middleware "github.com/kittipat1413/go-common/framework/middleware/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbortWithProblemDetails(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	notFoundErr := domain_error.NewNotFoundError("user not found", nil)
+	router.GET("/users/:id", func(c *gin.Context) {
+		err := middleware.AbortWithProblemDetails(c, notFoundErr)
+		assert.Equal(t, notFoundErr, err)
+		assert.True(t, c.IsAborted())
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/users/42", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, domain_error.ProblemDetailsContentType, w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"type": "about:blank",
+		"title": "Not Found",
+		"status": 404,
+		"detail": "user not found",
+		"instance": "/users/42",
+		"code": "`+domain_error.GetFullCode(domain_error.StatusCodeGenericNotFoundError)+`"
+	}`, w.Body.String())
+}
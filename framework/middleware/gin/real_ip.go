@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// ForwardedForHeader is the header listing the client and the proxies a request passed through.
+	ForwardedForHeader = "X-Forwarded-For"
+	// RealIPHeader is the header set by some proxies (e.g., nginx) to the client IP.
+	RealIPHeader = "X-Real-IP"
+)
+
+// realIPKey is an unexported type for context keys defined in this package.
+type realIPKey struct{}
+
+// realIPContextKey is the key for client IP values in context.
+var realIPContextKey = &realIPKey{}
+
+// GetRealIPFromContext retrieves the client IP computed by the RealIP middleware from the context.
+func GetRealIPFromContext(ctx context.Context) (string, bool) {
+	ip, ok := ctx.Value(realIPContextKey).(string)
+	return ip, ok
+}
+
+// RealIP returns a Gin middleware that computes the client IP of each request and stores it in the request context,
+// where it can be retrieved with GetRealIPFromContext (e.g., for logging or rate limiting).
+//
+// Forwarded headers can be set by anyone, so they are only trusted when the immediate peer (the request's RemoteAddr)
+// is one of the trusted proxies, given as IP addresses (e.g., "10.0.0.1") or CIDR ranges (e.g., "10.0.0.0/8").
+//
+// The middleware performs the following tasks:
+//  1. If the immediate peer is not a trusted proxy, the client IP is the peer IP and forwarded headers are ignored.
+//  2. Otherwise, walks the `X-Forwarded-For` header from right to left, skipping trusted proxies, and uses the first
+//     untrusted address. Addresses to the left of it were supplied by the client and may be spoofed.
+//  3. If `X-Forwarded-For` is missing, uses the `X-Real-IP` header, falling back to the peer IP.
+//
+// It panics if a trusted proxy is neither a valid IP address nor a valid CIDR range.
+//
+// Example Usage:
+//
+//	router.Use(RealIP([]string{"10.0.0.0/8"}))
+//	router.GET("/", func(c *gin.Context) {
+//		ip, _ := GetRealIPFromContext(c.Request.Context())
+//		c.String(http.StatusOK, ip)
+//	})
+func RealIP(trustedProxies []string) gin.HandlerFunc {
+	trustedNets := make([]*net.IPNet, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		ipNet, err := parseTrustedProxy(proxy)
+		if err != nil {
+			panic(err)
+		}
+		trustedNets = append(trustedNets, ipNet)
+	}
+
+	isTrusted := func(ip net.IP) bool {
+		for _, ipNet := range trustedNets {
+			if ipNet.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(c *gin.Context) {
+		// A proxy may append its own header line instead of extending the existing one, so all lines are combined
+		// in order; reading only the first line would trust the client-supplied value.
+		forwardedFor := strings.Join(c.Request.Header.Values(ForwardedForHeader), ",")
+		clientIP := resolveClientIP(c.Request.RemoteAddr, forwardedFor, c.GetHeader(RealIPHeader), isTrusted)
+
+		// Store the client IP in the context for downstream handlers.
+		ctx := context.WithValue(c.Request.Context(), realIPContextKey, clientIP)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
+// parseTrustedProxy parses an IP address or CIDR range into an IP network.
+func parseTrustedProxy(proxy string) (*net.IPNet, error) {
+	proxy = strings.TrimSpace(proxy)
+	if strings.Contains(proxy, "/") {
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		return ipNet, nil
+	}
+
+	ip := net.ParseIP(proxy)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid trusted proxy %q: not an IP address or CIDR range", proxy)
+	}
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits = ip4, 8*net.IPv4len
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// resolveClientIP computes the client IP from the peer address and the forwarded headers.
+func resolveClientIP(remoteAddr, forwardedFor, realIP string, isTrusted func(net.IP) bool) string {
+	peer := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		peer = host
+	}
+	peerIP := net.ParseIP(peer)
+	if peerIP == nil || !isTrusted(peerIP) {
+		return peer
+	}
+
+	if forwardedFor != "" {
+		// The rightmost address was added by the closest proxy; walk left until the first untrusted address.
+		clientIP := peer
+		hops := strings.Split(forwardedFor, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				// Stop at a malformed entry and keep the last valid address.
+				break
+			}
+			clientIP = ip.String()
+			if !isTrusted(ip) {
+				break
+			}
+		}
+		return clientIP
+	}
+
+	if ip := net.ParseIP(strings.TrimSpace(realIP)); ip != nil {
+		return ip.String()
+	}
+	return peer
+}
@@ -0,0 +1,156 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	middleware "github.com/kittipat1413/go-common/framework/middleware/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRealIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.RealIP([]string{"10.0.0.0/8", "192.168.1.1"}))
+	router.GET("/", func(c *gin.Context) {
+		ip, ok := middleware.GetRealIPFromContext(c.Request.Context())
+		assert.True(t, ok)
+		c.String(http.StatusOK, ip)
+	})
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		realIP       string
+		expectedIP   string
+	}{
+		{
+			name:       "untrusted peer without headers",
+			remoteAddr: "203.0.113.7:1234",
+			expectedIP: "203.0.113.7",
+		},
+		{
+			name:         "untrusted peer with spoofed X-Forwarded-For",
+			remoteAddr:   "203.0.113.7:1234",
+			forwardedFor: "1.2.3.4",
+			expectedIP:   "203.0.113.7",
+		},
+		{
+			name:       "untrusted peer with spoofed X-Real-IP",
+			remoteAddr: "203.0.113.7:1234",
+			realIP:     "1.2.3.4",
+			expectedIP: "203.0.113.7",
+		},
+		{
+			name:         "trusted peer (CIDR) with X-Forwarded-For",
+			remoteAddr:   "10.1.2.3:1234",
+			forwardedFor: "198.51.100.20",
+			expectedIP:   "198.51.100.20",
+		},
+		{
+			name:         "trusted peer (IP) with X-Forwarded-For",
+			remoteAddr:   "192.168.1.1:1234",
+			forwardedFor: "198.51.100.20",
+			expectedIP:   "198.51.100.20",
+		},
+		{
+			name:         "trusted peer skips trusted hops",
+			remoteAddr:   "10.1.2.3:1234",
+			forwardedFor: "198.51.100.20, 10.0.0.5, 10.0.0.6",
+			expectedIP:   "198.51.100.20",
+		},
+		{
+			name:         "client-supplied spoofed entry is ignored",
+			remoteAddr:   "10.1.2.3:1234",
+			forwardedFor: "1.2.3.4, 198.51.100.20",
+			expectedIP:   "198.51.100.20",
+		},
+		{
+			name:         "all hops trusted uses leftmost",
+			remoteAddr:   "10.1.2.3:1234",
+			forwardedFor: "10.0.0.5, 10.0.0.6",
+			expectedIP:   "10.0.0.5",
+		},
+		{
+			name:         "malformed entry stops at last valid address",
+			remoteAddr:   "10.1.2.3:1234",
+			forwardedFor: "198.51.100.20, not-an-ip, 10.0.0.6",
+			expectedIP:   "10.0.0.6",
+		},
+		{
+			name:       "trusted peer with X-Real-IP",
+			remoteAddr: "10.1.2.3:1234",
+			realIP:     "198.51.100.20",
+			expectedIP: "198.51.100.20",
+		},
+		{
+			name:       "trusted peer without headers",
+			remoteAddr: "10.1.2.3:1234",
+			expectedIP: "10.1.2.3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				req.Header.Set(middleware.ForwardedForHeader, tt.forwardedFor)
+			}
+			if tt.realIP != "" {
+				req.Header.Set(middleware.RealIPHeader, tt.realIP)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.expectedIP, w.Body.String())
+		})
+	}
+}
+
+func TestRealIP_NoTrustedProxies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.RealIP(nil))
+	router.GET("/", func(c *gin.Context) {
+		ip, _ := middleware.GetRealIPFromContext(c.Request.Context())
+		c.String(http.StatusOK, ip)
+	})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.1.2.3:1234"
+	req.Header.Set(middleware.ForwardedForHeader, "1.2.3.4")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, "10.1.2.3", w.Body.String())
+}
+
+func TestRealIP_MultipleForwardedForHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.RealIP([]string{"10.0.0.0/8"}))
+	router.GET("/", func(c *gin.Context) {
+		ip, _ := middleware.GetRealIPFromContext(c.Request.Context())
+		c.String(http.StatusOK, ip)
+	})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	// The client sends a spoofed header line, and the proxy appends a second line with the actual client address.
+	req.Header.Add(middleware.ForwardedForHeader, "6.6.6.6")
+	req.Header.Add(middleware.ForwardedForHeader, "1.2.3.4, 10.0.0.2")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, "1.2.3.4", w.Body.String())
+}
+
+func TestRealIP_InvalidTrustedProxy(t *testing.T) {
+	assert.Panics(t, func() { middleware.RealIP([]string{"not-an-ip"}) })
+	assert.Panics(t, func() { middleware.RealIP([]string{"10.0.0.0/99"}) })
+}
@@ -10,8 +10,10 @@ import (
 
 // requestLoggerOptions holds configuration options for the RequestLogger middleware.
 type requestLoggerOptions struct {
-	logger  common_logger.Logger
-	filters []RequestLoggerFilter
+	logger        common_logger.Logger
+	filters       []RequestLoggerFilter
+	slowThreshold time.Duration // Latency above which requests are logged at Warn level. Zero disables it.
+	onlySlow      bool          // Whether to skip logging requests that are not slow.
 }
 
 // RequestLoggerOption is a function that configures requestLoggerOptions.
@@ -34,11 +36,27 @@ func WithRequestLoggerFilter(filters ...RequestLoggerFilter) RequestLoggerOption
 	}
 }
 
+// WithRequestLoggerSlowThreshold sets the latency above which requests are considered slow.
+// Slow requests are logged at Warn level (instead of Info) with `"slow": true` in the response fields.
+func WithRequestLoggerSlowThreshold(threshold time.Duration) RequestLoggerOption {
+	return func(opts *requestLoggerOptions) {
+		opts.slowThreshold = threshold
+	}
+}
+
+// WithRequestLoggerOnlySlow skips logging requests that are not slow, reducing log volume while surfacing tail latency.
+// It only takes effect when a slow threshold is set with WithRequestLoggerSlowThreshold.
+func WithRequestLoggerOnlySlow(onlySlow bool) RequestLoggerOption {
+	return func(opts *requestLoggerOptions) {
+		opts.onlySlow = onlySlow
+	}
+}
+
 // RequestLogger returns a Gin middleware that logs detailed information about HTTP requests and responses.
 // It also augments the logger with request-specific fields and stores it in the context for downstream handlers.
 //
 // Functionality:
-//   - Logs request details, such as method, route, query parameters, client IP, and user agent.
+//   - Logs request details, such as method, route, query parameters, client IP (from the RealIP middleware, if used), and user agent.
 //   - Measures and logs the request latency and response status code.
 //   - Allows filtering of requests to determine whether they should be logged.
 //   - Injects an augmented logger with request-specific fields into the request context for downstream use.
@@ -46,6 +64,8 @@ func WithRequestLoggerFilter(filters ...RequestLoggerFilter) RequestLoggerOption
 // Key Features:
 //   - Custom Logger: Use `WithRequestLogger` to provide a custom logger. If not provided, a default logger is used.
 //   - Request Filters: Use `WithRequestLoggerFilter` to specify one or more filters. Requests that do not pass the filters will not be logged.
+//   - Slow Requests: Use `WithRequestLoggerSlowThreshold` to log requests slower than the threshold at Warn level,
+//     and `WithRequestLoggerOnlySlow` to log only those.
 //   - Request Context Integration: The middleware adds an augmented logger to the request context, allowing downstream handlers to use it for logging.
 //
 // Example Usage:
@@ -84,6 +104,10 @@ func RequestLogger(opts ...RequestLoggerOption) gin.HandlerFunc {
 
 		// Create a logger with request-specific fields.
 		requestID, _ := GetRequestIDFromContext(c.Request.Context())
+		clientIP, ok := GetRealIPFromContext(c.Request.Context())
+		if !ok {
+			clientIP = c.ClientIP()
+		}
 		loggerWithFields := options.logger.WithFields(common_logger.Fields{
 			"request": common_logger.Fields{
 				"method":      c.Request.Method,
@@ -91,7 +115,7 @@ func RequestLogger(opts ...RequestLoggerOption) gin.HandlerFunc {
 				"path":        c.Request.URL.Path,
 				"query":       c.Request.URL.RawQuery,
 				"request_uri": c.Request.RequestURI,
-				"client_ip":   c.ClientIP(),
+				"client_ip":   clientIP,
 				"user_agent":  c.Request.UserAgent(),
 				"request_id":  requestID,
 			},
@@ -106,15 +130,23 @@ func RequestLogger(opts ...RequestLoggerOption) gin.HandlerFunc {
 
 		// Calculate latency.
 		latency := time.Since(startTime)
+		slow := options.slowThreshold > 0 && latency > options.slowThreshold
+		if options.onlySlow && options.slowThreshold > 0 && !slow {
+			return
+		}
 		// Get the status code of the response.
 		statusCode := c.Writer.Status()
+		responseFields := common_logger.Fields{
+			"status_code": statusCode,
+			"latency_ms":  latency.Milliseconds(),
+			"latency_s":   latency.Seconds(),
+		}
 		// Log the request information.
-		loggerWithFields.Info(ctx, "Request information", common_logger.Fields{
-			"response": common_logger.Fields{
-				"status_code": statusCode,
-				"latency_ms":  latency.Milliseconds(),
-				"latency_s":   latency.Seconds(),
-			},
-		})
+		if slow {
+			responseFields["slow"] = true
+			loggerWithFields.Warn(ctx, "Request information", common_logger.Fields{"response": responseFields})
+			return
+		}
+		loggerWithFields.Info(ctx, "Request information", common_logger.Fields{"response": responseFields})
 	}
 }
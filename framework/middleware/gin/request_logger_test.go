@@ -2,9 +2,11 @@ package middleware_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	common_logger "github.com/kittipat1413/go-common/framework/logger"
@@ -151,3 +153,63 @@ func TestRequestLogger_ContextLogger(t *testing.T) {
 	assert.Contains(t, logs, `"message":"Handler log message"`)
 	assert.Contains(t, logs, `"path":"/test"`)
 }
+
+func TestRequestLogger_SlowThreshold(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name             string
+		onlySlow         bool
+		path             string
+		expectedSeverity string // Empty if no log is expected.
+	}{
+		{name: "fast request is logged at info", path: "/fast", expectedSeverity: "info"},
+		{name: "slow request is logged at warn", path: "/slow", expectedSeverity: "warning"},
+		{name: "fast request is suppressed with only slow", onlySlow: true, path: "/fast", expectedSeverity: ""},
+		{name: "slow request is logged at warn with only slow", onlySlow: true, path: "/slow", expectedSeverity: "warning"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logOutput bytes.Buffer
+			logger, err := common_logger.NewLogger(common_logger.Config{
+				Level:  common_logger.INFO,
+				Output: &logOutput,
+			})
+			require.NoError(t, err)
+
+			router := gin.New()
+			router.Use(middleware.RequestLogger(
+				middleware.WithRequestLogger(logger),
+				middleware.WithRequestLoggerSlowThreshold(20*time.Millisecond),
+				middleware.WithRequestLoggerOnlySlow(tt.onlySlow),
+			))
+			router.GET("/fast", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+			router.GET("/slow", func(c *gin.Context) {
+				time.Sleep(30 * time.Millisecond)
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("GET", tt.path, nil))
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			if tt.expectedSeverity == "" {
+				assert.Empty(t, logOutput.String())
+				return
+			}
+			var logEntry map[string]interface{}
+			require.NoError(t, json.Unmarshal(logOutput.Bytes(), &logEntry))
+			assert.Equal(t, tt.expectedSeverity, logEntry["severity"])
+			response, ok := logEntry["response"].(map[string]interface{})
+			require.True(t, ok)
+			if tt.path == "/slow" {
+				assert.Equal(t, true, response["slow"])
+			} else {
+				assert.NotContains(t, response, "slow")
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kittipat1413/go-common/framework/cache"
+	"github.com/kittipat1413/go-common/framework/cache/localcache"
+	common_logger "github.com/kittipat1413/go-common/framework/logger"
+	"golang.org/x/sync/singleflight"
+)
+
+// CacheStatusHeader is the response header set by the Cache middleware to "HIT" or "MISS".
+const CacheStatusHeader = "X-Cache"
+
+// CachedResponse is an HTTP response stored by the Cache middleware.
+type CachedResponse struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+// CacheStore stores responses for the Cache middleware.
+// Implement it to share cached responses between instances (e.g., backed by Redis).
+type CacheStore interface {
+	// Get returns the response stored under key, and whether it was found.
+	Get(ctx context.Context, key string) (CachedResponse, bool, error)
+	// Set stores the response under key for the given TTL.
+	Set(ctx context.Context, key string, response CachedResponse, ttl time.Duration) error
+}
+
+// inMemoryCacheStore is a CacheStore backed by a localcache.
+type inMemoryCacheStore struct {
+	cache cache.Cache[CachedResponse]
+}
+
+// NewInMemoryCacheStore returns a CacheStore that keeps responses in process memory.
+// Expired responses are removed periodically (see localcache.WithCleanupInterval).
+func NewInMemoryCacheStore(opts ...localcache.Option) CacheStore {
+	return &inMemoryCacheStore{cache: localcache.New[CachedResponse](opts...)}
+}
+
+func (s *inMemoryCacheStore) Get(ctx context.Context, key string) (CachedResponse, bool, error) {
+	response, err := s.cache.Get(ctx, key, nil)
+	if errors.Is(err, cache.ErrCacheMiss) {
+		return CachedResponse{}, false, nil
+	}
+	if err != nil {
+		return CachedResponse{}, false, err
+	}
+	return response, true, nil
+}
+
+func (s *inMemoryCacheStore) Set(ctx context.Context, key string, response CachedResponse, ttl time.Duration) error {
+	s.cache.Set(ctx, key, response, &ttl)
+	return nil
+}
+
+// cacheOptions holds configuration options for the Cache middleware.
+type cacheOptions struct {
+	varyHeaders []string                    // Request headers whose values are part of the cache key.
+	keyFunc     func(c *gin.Context) string // Custom cache key function.
+	logger      common_logger.Logger        // logger is the custom logger to use. If nil, the logger will be retrieved from the context.
+}
+
+// CacheOption is a function that configures cacheOptions.
+type CacheOption func(*cacheOptions)
+
+// WithCacheVaryHeaders adds request headers (e.g., "Accept-Language") whose values are part of the cache key,
+// so requests that differ in these headers are cached separately.
+func WithCacheVaryHeaders(headers ...string) CacheOption {
+	return func(opts *cacheOptions) {
+		opts.varyHeaders = append(opts.varyHeaders, headers...)
+	}
+}
+
+// WithCacheKeyFunc sets a custom function to compute the cache key of a request (e.g., to include the user ID).
+// It replaces the default key built from the method, URI, and vary headers.
+func WithCacheKeyFunc(keyFunc func(c *gin.Context) string) CacheOption {
+	return func(opts *cacheOptions) {
+		if keyFunc != nil {
+			opts.keyFunc = keyFunc
+		}
+	}
+}
+
+// WithCacheLogger sets a custom logger for the Cache middleware, used to log cache store errors.
+func WithCacheLogger(logger common_logger.Logger) CacheOption {
+	return func(opts *cacheOptions) {
+		opts.logger = logger
+	}
+}
+
+// cacheFlightResult is the outcome of a handler execution shared between concurrent requests for the same key.
+type cacheFlightResult struct {
+	response  CachedResponse
+	cacheable bool
+}
+
+// Cache returns a Gin middleware that caches successful (2xx) responses to GET requests and serves them
+// from the store until the TTL expires.
+//
+// The middleware performs the following tasks:
+//  1. Computes the cache key from the method, request URI (path and query), and configured vary headers.
+//  2. On a cache hit, writes the stored response with an `X-Cache: HIT` header and aborts the chain.
+//  3. On a cache miss, runs the handler chain once per key, even under concurrent misses (single-flight),
+//     so an expired entry does not trigger a stampede of recomputations. The response is stored if it is successful
+//     and its `Cache-Control` header does not contain `no-store` or `private`. `Set-Cookie` headers are never stored,
+//     so a cookie set for one client is not sent to others.
+//     Concurrent requests waiting on the same key are served the shared response; if it was not cacheable
+//     (or the handler chain panicked), they run the handler chain themselves. A panic is only propagated
+//     to the request that ran the chain.
+//
+// Requests with other methods pass through untouched.
+//
+// The default key does not identify the user, so every client shares the cached response of a URI. For routes whose
+// responses depend on the authenticated user, use WithCacheKeyFunc to include the user (or tenant) in the key, or
+// mark the responses `Cache-Control: private`.
+//
+// Example Usage:
+//
+//	store := NewInMemoryCacheStore()
+//	router.GET("/reports", Cache(store, time.Minute, WithCacheVaryHeaders("Accept-Language")), reportsHandler)
+func Cache(store CacheStore, ttl time.Duration, opts ...CacheOption) gin.HandlerFunc {
+	// Set default options.
+	options := &cacheOptions{}
+
+	// Apply any user-provided options.
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.keyFunc == nil {
+		options.keyFunc = func(c *gin.Context) string {
+			return defaultCacheKey(c, options.varyHeaders)
+		}
+	}
+
+	var group singleflight.Group
+
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		logger := options.logger
+		if logger == nil {
+			logger = common_logger.FromContext(ctx)
+		}
+
+		key := options.keyFunc(c)
+		if response, found, err := store.Get(ctx, key); err != nil {
+			logger.Error(ctx, "Failed to read response from cache", err, common_logger.Fields{"cache_key": key})
+		} else if found {
+			writeCachedResponse(c, response)
+			return
+		}
+
+		isLeader := false
+		var recovered interface{}
+		result, _, _ := group.Do(key, func() (result interface{}, err error) {
+			isLeader = true
+			// Recover a panic of the handler chain so that waiting requests get a non-cacheable result and run
+			// the chain themselves, instead of singleflight re-panicking in each of them. The leader re-panics below.
+			defer func() {
+				if r := recover(); r != nil {
+					recovered = r
+					result = cacheFlightResult{}
+				}
+			}()
+
+			original := c.Writer
+			writer := &cacheResponseWriter{ResponseWriter: original}
+			c.Writer = writer
+			// Restore the original writer once the chain has run.
+			defer func() { c.Writer = original }()
+			c.Header(CacheStatusHeader, "MISS")
+			c.Next()
+
+			status := writer.Status()
+			header := writer.Header().Clone()
+			header.Del("Set-Cookie")
+			flight := cacheFlightResult{
+				response: CachedResponse{
+					Status: status,
+					Header: header,
+					Body:   writer.body.Bytes(),
+				},
+				cacheable: status >= http.StatusOK && status < http.StatusMultipleChoices && isStorable(header),
+			}
+			if flight.cacheable {
+				if err := store.Set(ctx, key, flight.response, ttl); err != nil {
+					logger.Error(ctx, "Failed to write response to cache", err, common_logger.Fields{"cache_key": key})
+				}
+			}
+			return flight, nil
+		})
+		if isLeader {
+			if recovered != nil {
+				panic(recovered)
+			}
+			return
+		}
+
+		// Another request computed the response concurrently.
+		// The result is not cacheable if the leader's handler chain panicked.
+		if flight, ok := result.(cacheFlightResult); ok && flight.cacheable {
+			writeCachedResponse(c, flight.response)
+			return
+		}
+		c.Next()
+	}
+}
+
+// defaultCacheKey builds the cache key from the method, request URI, and the values of the vary headers.
+func defaultCacheKey(c *gin.Context, varyHeaders []string) string {
+	var b strings.Builder
+	b.WriteString(c.Request.Method)
+	b.WriteByte(' ')
+	b.WriteString(c.Request.URL.RequestURI())
+	for _, header := range varyHeaders {
+		b.WriteByte('|')
+		b.WriteString(http.CanonicalHeaderKey(header))
+		b.WriteByte('=')
+		b.WriteString(strings.Join(c.Request.Header.Values(header), ","))
+	}
+	return b.String()
+}
+
+// isStorable reports whether the response's `Cache-Control` header allows a shared cache to store it,
+// i.e. it contains neither the `no-store` nor the `private` directive.
+func isStorable(header http.Header) bool {
+	for _, value := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			name, _, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			switch strings.ToLower(strings.TrimSpace(name)) {
+			case "no-store", "private":
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// writeCachedResponse writes the cached response with an `X-Cache: HIT` header and aborts the chain.
+func writeCachedResponse(c *gin.Context, response CachedResponse) {
+	header := c.Writer.Header()
+	for key, values := range response.Header {
+		header[key] = append([]string(nil), values...)
+	}
+	header.Set(CacheStatusHeader, "HIT")
+	c.Writer.WriteHeader(response.Status)
+	_, _ = c.Writer.Write(response.Body)
+	c.Abort()
+}
+
+// cacheResponseWriter wraps gin.ResponseWriter to capture the response body while writing it through.
+type cacheResponseWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *cacheResponseWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *cacheResponseWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,286 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	middleware "github.com/kittipat1413/go-common/framework/middleware/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupCacheRouter(store middleware.CacheStore, ttl time.Duration, handler gin.HandlerFunc, opts ...middleware.CacheOption) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	cache := middleware.Cache(store, ttl, opts...)
+	router.GET("/reports", cache, handler)
+	router.POST("/reports", cache, handler)
+	return router
+}
+
+func TestCache_SecondRequestServedFromCache(t *testing.T) {
+	var calls atomic.Int32
+	router := setupCacheRouter(middleware.NewInMemoryCacheStore(), time.Minute, func(c *gin.Context) {
+		n := calls.Add(1)
+		c.Header("X-Report-Version", "v1")
+		c.JSON(http.StatusOK, gin.H{"call": n})
+	})
+
+	first := httptest.NewRecorder()
+	router.ServeHTTP(first, httptest.NewRequest("GET", "/reports?page=1", nil))
+	require.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, "MISS", first.Header().Get(middleware.CacheStatusHeader))
+	assert.JSONEq(t, `{"call": 1}`, first.Body.String())
+
+	second := httptest.NewRecorder()
+	router.ServeHTTP(second, httptest.NewRequest("GET", "/reports?page=1", nil))
+	require.Equal(t, http.StatusOK, second.Code)
+	assert.Equal(t, "HIT", second.Header().Get(middleware.CacheStatusHeader))
+	assert.Equal(t, "v1", second.Header().Get("X-Report-Version"))
+	assert.Equal(t, "application/json; charset=utf-8", second.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"call": 1}`, second.Body.String())
+
+	assert.Equal(t, int32(1), calls.Load(), "handler should be called once")
+
+	// A different query is cached separately.
+	third := httptest.NewRecorder()
+	router.ServeHTTP(third, httptest.NewRequest("GET", "/reports?page=2", nil))
+	assert.JSONEq(t, `{"call": 2}`, third.Body.String())
+}
+
+func TestCache_ConcurrentMissesRunHandlerOnce(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	router := setupCacheRouter(middleware.NewInMemoryCacheStore(), time.Minute, func(c *gin.Context) {
+		calls.Add(1)
+		<-release
+		c.String(http.StatusOK, "expensive")
+	})
+
+	const concurrency = 10
+	var wg sync.WaitGroup
+	recorders := make([]*httptest.ResponseRecorder, concurrency)
+	for i := 0; i < concurrency; i++ {
+		recorders[i] = httptest.NewRecorder()
+		wg.Add(1)
+		go func(w *httptest.ResponseRecorder) {
+			defer wg.Done()
+			router.ServeHTTP(w, httptest.NewRequest("GET", "/reports", nil))
+		}(recorders[i])
+	}
+
+	// Wait for the first handler execution, then give the other requests time to queue up behind it.
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load(), "handler should be called once for concurrent misses")
+	for _, w := range recorders {
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "expensive", w.Body.String())
+	}
+}
+
+func TestCache_PanickingLeaderDoesNotFailWaiters(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(gin.CustomRecovery(func(c *gin.Context, recovered interface{}) {
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}))
+	router.GET("/reports", middleware.Cache(middleware.NewInMemoryCacheStore(), time.Minute), func(c *gin.Context) {
+		if calls.Add(1) == 1 {
+			<-release
+			panic("boom")
+		}
+		c.String(http.StatusOK, "recomputed")
+	})
+
+	const concurrency = 3
+	var wg sync.WaitGroup
+	recorders := make([]*httptest.ResponseRecorder, concurrency)
+	for i := 0; i < concurrency; i++ {
+		recorders[i] = httptest.NewRecorder()
+		wg.Add(1)
+		go func(w *httptest.ResponseRecorder) {
+			defer wg.Done()
+			router.ServeHTTP(w, httptest.NewRequest("GET", "/reports", nil))
+		}(recorders[i])
+	}
+
+	// Wait for the first handler execution, then give the other requests time to queue up behind it.
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// Only the leader gets the recovery response; the waiters run the handler chain themselves.
+	codes := map[int]int{}
+	for _, w := range recorders {
+		codes[w.Code]++
+		if w.Code == http.StatusOK {
+			assert.Equal(t, "recomputed", w.Body.String())
+		}
+	}
+	assert.Equal(t, map[int]int{http.StatusInternalServerError: 1, http.StatusOK: concurrency - 1}, codes)
+	assert.Equal(t, int32(concurrency), calls.Load())
+}
+
+func TestCache_NonSuccessResponsesAreNotCached(t *testing.T) {
+	var calls atomic.Int32
+	router := setupCacheRouter(middleware.NewInMemoryCacheStore(), time.Minute, func(c *gin.Context) {
+		calls.Add(1)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "boom"})
+	})
+
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/reports", nil))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, "MISS", w.Header().Get(middleware.CacheStatusHeader))
+	}
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestCache_SetCookieIsNotStored(t *testing.T) {
+	var calls atomic.Int32
+	router := setupCacheRouter(middleware.NewInMemoryCacheStore(), time.Minute, func(c *gin.Context) {
+		n := calls.Add(1)
+		c.SetCookie("session", fmt.Sprintf("client-%d", n), 3600, "/", "", true, true)
+		c.JSON(http.StatusOK, gin.H{"call": n})
+	})
+
+	first := httptest.NewRecorder()
+	router.ServeHTTP(first, httptest.NewRequest("GET", "/reports", nil))
+	assert.Equal(t, "MISS", first.Header().Get(middleware.CacheStatusHeader))
+	assert.Contains(t, first.Header().Get("Set-Cookie"), "session=client-1")
+
+	// Another client is served the cached body without the first client's cookie.
+	second := httptest.NewRecorder()
+	router.ServeHTTP(second, httptest.NewRequest("GET", "/reports", nil))
+	assert.Equal(t, "HIT", second.Header().Get(middleware.CacheStatusHeader))
+	assert.Empty(t, second.Header().Values("Set-Cookie"))
+	assert.JSONEq(t, `{"call": 1}`, second.Body.String())
+}
+
+func TestCache_UncacheableResponsesAreNotCached(t *testing.T) {
+	for _, cacheControl := range []string{"no-store", "private", "Private, max-age=60", "max-age=60, no-store", `private="Set-Cookie"`} {
+		t.Run(cacheControl, func(t *testing.T) {
+			var calls atomic.Int32
+			router := setupCacheRouter(middleware.NewInMemoryCacheStore(), time.Minute, func(c *gin.Context) {
+				calls.Add(1)
+				c.Header("Cache-Control", cacheControl)
+				c.JSON(http.StatusOK, gin.H{"ok": true})
+			})
+
+			for i := 0; i < 2; i++ {
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, httptest.NewRequest("GET", "/reports", nil))
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, "MISS", w.Header().Get(middleware.CacheStatusHeader))
+			}
+			assert.Equal(t, int32(2), calls.Load())
+		})
+	}
+
+	t.Run("public", func(t *testing.T) {
+		var calls atomic.Int32
+		router := setupCacheRouter(middleware.NewInMemoryCacheStore(), time.Minute, func(c *gin.Context) {
+			calls.Add(1)
+			c.Header("Cache-Control", "public, max-age=60")
+			c.JSON(http.StatusOK, gin.H{"ok": true})
+		})
+
+		for i := 0; i < 2; i++ {
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/reports", nil))
+		}
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+func TestCache_NonGetRequestsPassThrough(t *testing.T) {
+	var calls atomic.Int32
+	router := setupCacheRouter(middleware.NewInMemoryCacheStore(), time.Minute, func(c *gin.Context) {
+		calls.Add(1)
+		c.String(http.StatusOK, "created")
+	})
+
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("POST", "/reports", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get(middleware.CacheStatusHeader))
+	}
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestCache_TTLExpiry(t *testing.T) {
+	var calls atomic.Int32
+	router := setupCacheRouter(middleware.NewInMemoryCacheStore(), 20*time.Millisecond, func(c *gin.Context) {
+		calls.Add(1)
+		c.String(http.StatusOK, "ok")
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/reports", nil))
+	time.Sleep(40 * time.Millisecond)
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/reports", nil))
+
+	assert.Equal(t, int32(2), calls.Load(), "expired responses should be recomputed")
+}
+
+func TestCache_VaryHeaders(t *testing.T) {
+	router := setupCacheRouter(middleware.NewInMemoryCacheStore(), time.Minute, func(c *gin.Context) {
+		c.String(http.StatusOK, "lang="+c.GetHeader("Accept-Language"))
+	}, middleware.WithCacheVaryHeaders("Accept-Language"))
+
+	for _, lang := range []string{"en", "th", "en"} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/reports", nil)
+		req.Header.Set("Accept-Language", lang)
+		router.ServeHTTP(w, req)
+		assert.Equal(t, "lang="+lang, w.Body.String())
+	}
+}
+
+// failingCacheStore is a CacheStore whose operations always fail.
+type failingCacheStore struct{}
+
+func (failingCacheStore) Get(ctx context.Context, key string) (middleware.CachedResponse, bool, error) {
+	return middleware.CachedResponse{}, false, errors.New("store unavailable")
+}
+
+func (failingCacheStore) Set(ctx context.Context, key string, response middleware.CachedResponse, ttl time.Duration) error {
+	return errors.New("store unavailable")
+}
+
+func TestCache_StoreErrors(t *testing.T) {
+	var calls atomic.Int32
+	var keys []string
+	router := setupCacheRouter(failingCacheStore{}, time.Minute, func(c *gin.Context) {
+		calls.Add(1)
+		c.String(http.StatusOK, "ok")
+	}, middleware.WithCacheKeyFunc(func(c *gin.Context) string {
+		key := fmt.Sprintf("custom:%s", c.Request.URL.Path)
+		keys = append(keys, key)
+		return key
+	}))
+
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/reports", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "ok", w.Body.String())
+	}
+	assert.Equal(t, int32(2), calls.Load(), "requests should still be served when the store fails")
+	assert.Equal(t, []string{"custom:/reports", "custom:/reports"}, keys)
+}
@@ -212,8 +212,10 @@ func buildRequestAttributes(c *gin.Context) []attribute.KeyValue {
 		attributes = append(attributes, semconv.NetSockPeerAddrKey.String(c.Request.RemoteAddr))
 	}
 
-	// Add client IP address from X-Forwarded-For header if available.
-	if xForwardedFor := c.Request.Header.Get("X-Forwarded-For"); xForwardedFor != "" {
+	// Add the client IP computed by the RealIP middleware, or from the X-Forwarded-For header if available.
+	if realIP, ok := GetRealIPFromContext(c.Request.Context()); ok {
+		attributes = append(attributes, semconv.HTTPClientIPKey.String(realIP))
+	} else if xForwardedFor := c.Request.Header.Get(ForwardedForHeader); xForwardedFor != "" {
 		// Use the first IP in the list.
 		if idx := strings.Index(xForwardedFor, ","); idx >= 0 {
 			xForwardedFor = xForwardedFor[:idx]
@@ -317,3 +317,20 @@ func TestValidateTimeOnly(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDateWithDive(t *testing.T) {
+	v, _ := validator.NewValidator(
+		validator.WithCustomValidator(new(custom_validator.DateValidator)),
+	)
+
+	type Schedule struct {
+		Holidays []string `validate:"dive,date=dateonly"`
+	}
+
+	err := v.ValidateStruct(Schedule{Holidays: []string{"2024-01-01", "2024-12-25"}})
+	assert.NoError(t, err)
+
+	err = v.ValidateStruct(Schedule{Holidays: []string{"2024-01-01", "25/12/2024"}})
+	assert.Error(t, err)
+	assert.Equal(t, "Holidays[1] must be a valid date in 'dateonly' format", err.Error())
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+
+	ut "github.com/go-playground/universal-translator"
+	validatorV10 "github.com/go-playground/validator/v10"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+var _ validator.CustomValidator = (*SKUValidator)(nil)
+
+// skuPattern matches SKUs such as "ABC-1234".
+var skuPattern = regexp.MustCompile(`^[A-Z]{3}-[0-9]{4}$`)
+
+// SKUValidator validates that a string is a SKU (e.g., "ABC-1234").
+type SKUValidator struct{}
+
+// Tag returns the tag identifier used in struct field validation tags.
+func (*SKUValidator) Tag() string {
+	return "sku"
+}
+
+// Func returns the validator.Func that performs the validation logic.
+func (*SKUValidator) Func() validatorV10.Func {
+	return func(fl validatorV10.FieldLevel) bool {
+		return skuPattern.MatchString(fl.Field().String())
+	}
+}
+
+// Translation returns the translation text and an custom translation function for the custom validator.
+func (*SKUValidator) Translation() (string, validatorV10.TranslationFunc) {
+	translationText := "{0} must be a valid SKU"
+
+	customTransFunc := func(ut ut.Translator, fe validatorV10.FieldError) string {
+		// {0} will be replaced with fe.Field()
+		t, _ := ut.T(fe.Tag(), fe.Field())
+		return t
+	}
+
+	return translationText, customTransFunc
+}
+
+type Item struct {
+	SKU      string `json:"sku" validate:"sku"`
+	Quantity int    `json:"quantity" validate:"gte=1"`
+}
+
+type Order struct {
+	// `dive` validates each element of the slice using the element's own tags.
+	Items []Item `json:"items" validate:"required,dive"`
+	// `dive` followed by tags applies those tags to each element of the slice.
+	RelatedSKUs []string `json:"related_skus" validate:"dive,sku"`
+	// `dive,keys,...,endkeys` applies tags to map keys; tags after `endkeys` apply to map values.
+	Discounts map[string]int `json:"discounts" validate:"dive,keys,sku,endkeys,gte=0,lte=100"`
+}
+
+func main() {
+	v, err := validator.NewValidator(
+		validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		validator.WithCustomValidator(new(SKUValidator)),
+	)
+	if err != nil {
+		fmt.Println("Error initializing validator:", err)
+		return
+	}
+
+	order := Order{
+		Items: []Item{
+			{SKU: "ABC-1234", Quantity: 1},
+			{SKU: "invalid", Quantity: 0},
+		},
+		RelatedSKUs: []string{"XYZ-0001", "bad"},
+		Discounts:   map[string]int{"ABC-1234": 150},
+	}
+
+	err = v.ValidateStruct(order)
+	if err != nil {
+		fmt.Println("Validation failed:", err)
+	} else {
+		fmt.Println("Validation passed")
+	}
+}
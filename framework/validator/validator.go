@@ -16,10 +16,11 @@ import (
 type Validator struct {
 	validate   *validator.Validate
 	translator ut.Translator
+	customTags map[string]struct{} // Tags registered with WithCustomValidator or RegisterValidator.
 }
 
 // ValidatorOption defines a functional option for configuring the validator instance.
-type ValidatorOption func(*validator.Validate, ut.Translator) error
+type ValidatorOption func(*Validator) error
 
 // NewValidator creates and returns a new Validator instance with the provided options.
 // It initializes the validator, applies custom options, sets up the translator, and registers default translations.
@@ -33,7 +34,7 @@ type ValidatorOption func(*validator.Validate, ut.Translator) error
 //	    // handle error
 //	}
 func NewValidator(opts ...ValidatorOption) (*Validator, error) {
-	v := validator.New(validator.WithRequiredStructEnabled())
+	validate := validator.New(validator.WithRequiredStructEnabled())
 
 	// Initialize the English locale and the universal translator.
 	enLocale := en.New()
@@ -44,28 +45,30 @@ func NewValidator(opts ...ValidatorOption) (*Validator, error) {
 		return nil, fmt.Errorf("translator not found for locale 'en'")
 	}
 	// Register English translations.
-	if err := enTranslations.RegisterDefaultTranslations(v, translator); err != nil {
+	if err := enTranslations.RegisterDefaultTranslations(validate, translator); err != nil {
 		return nil, err
 	}
 
+	v := &Validator{
+		validate:   validate,
+		translator: translator,
+		customTags: make(map[string]struct{}),
+	}
+
 	// Apply any custom validator options provided
 	for _, opt := range opts {
-		if err := opt(v, translator); err != nil {
+		if err := opt(v); err != nil {
 			return nil, err
 		}
 	}
-
-	return &Validator{
-		validate:   v,
-		translator: translator,
-	}, nil
+	return v, nil
 }
 
 // WithTagNameFunc registers a custom function to derive field names in validation errors.
 // For example, you can use this to specify that validation errors should display `json` tag names.
 func WithTagNameFunc(tagNameFunc func(fld reflect.StructField) string) ValidatorOption {
-	return func(v *validator.Validate, _ ut.Translator) error {
-		v.RegisterTagNameFunc(tagNameFunc)
+	return func(v *Validator) error {
+		v.validate.RegisterTagNameFunc(tagNameFunc)
 		return nil
 	}
 }
@@ -82,28 +85,120 @@ var JSONTagNameFunc = func(fld reflect.StructField) string {
 // WithCustomValidator registers a custom validator along with its translation.
 // It uses the CustomValidator interface to get the tag, function, and translation details.
 func WithCustomValidator(cv CustomValidator) ValidatorOption {
-	return func(v *validator.Validate, translator ut.Translator) error {
-		// Register the custom validation function
-		if err := v.RegisterValidation(cv.Tag(), cv.Func()); err != nil {
-			return err
-		}
+	return func(v *Validator) error {
+		return v.registerCustomValidator(cv)
+	}
+}
 
-		// Get the translation text and custom translation function
-		translationText, customTransFunc := cv.Translation()
+// registerCustomValidator registers the custom validator's function and, if provided, its translation,
+// and records its tag for HasValidator.
+func (v *Validator) registerCustomValidator(cv CustomValidator) error {
+	// Register the custom validation function
+	if err := v.validate.RegisterValidation(cv.Tag(), cv.Func()); err != nil {
+		return err
+	}
+	v.customTags[cv.Tag()] = struct{}{}
 
-		// Register the translation only if both translationText and customTransFunc are provided
-		if translationText == "" || customTransFunc == nil {
-			return nil // Skip registration if either component is missing
-		}
+	// Get the translation text and custom translation function
+	translationText, customTransFunc := cv.Translation()
 
-		// Register function for adding the translation
-		registerFn := func(ut ut.Translator) error {
-			return ut.Add(cv.Tag(), translationText, true)
-		}
+	// Register the translation only if both translationText and customTransFunc are provided
+	if translationText == "" || customTransFunc == nil {
+		return nil // Skip registration if either component is missing
+	}
+
+	// Register function for adding the translation
+	registerFn := func(ut ut.Translator) error {
+		return ut.Add(cv.Tag(), translationText, true)
+	}
+
+	// Register the translation with the custom function
+	return v.validate.RegisterTranslation(cv.Tag(), v.translator, registerFn, customTransFunc)
+}
+
+// RegisterValidator registers a custom validator (and its translation) after the Validator has been created.
+// It is useful for registering validators dynamically, e.g., only when a feature that needs them is enabled.
+//
+// Note: registration is not safe for concurrent use with validation; register validators during setup,
+// before the Validator is shared between goroutines.
+//
+// Example:
+//
+//	if !v.HasValidator("date") {
+//	    if err := v.RegisterValidator(customval.DateValidator{}); err != nil {
+//	        // handle error
+//	    }
+//	}
+func (v *Validator) RegisterValidator(cv CustomValidator) error {
+	if cv == nil {
+		return errors.New("custom validator is nil")
+	}
+	if err := v.registerCustomValidator(cv); err != nil {
+		return fmt.Errorf("failed to register validator %q: %w", cv.Tag(), err)
+	}
+	return nil
+}
+
+// StructLevel is the go-playground StructLevel interface passed to struct-level validation functions.
+// It is used to inspect the struct being validated and report field errors with ReportError.
+type StructLevel = validator.StructLevel
+
+// StructLevelFunc is a struct-level validation function, used for rules that span multiple fields.
+type StructLevelFunc = validator.StructLevelFunc
+
+// WithStructValidation registers a struct-level validation function for the given types.
+// See RegisterStructValidation for details.
+func WithStructValidation(fn StructLevelFunc, types ...interface{}) ValidatorOption {
+	return func(v *Validator) error {
+		return registerStructValidation(v.validate, fn, types...)
+	}
+}
+
+// registerStructValidation validates the arguments and registers the struct-level validation function.
+func registerStructValidation(v *validator.Validate, fn StructLevelFunc, types ...interface{}) error {
+	if fn == nil {
+		return errors.New("struct validation function is nil")
+	}
+	if len(types) == 0 {
+		return errors.New("no types provided for struct validation")
+	}
+	v.RegisterStructValidation(fn, types...)
+	return nil
+}
 
-		// Register the translation with the custom function
-		return v.RegisterTranslation(cv.Tag(), translator, registerFn, customTransFunc)
+// RegisterStructValidation registers a struct-level validation function for the given types, for rules that
+// span multiple fields (e.g., a start date that must be before an end date).
+//
+// Errors reported with `sl.ReportError` are surfaced like field-level errors: they are translated when the reported
+// tag has a translation (e.g., a built-in tag such as "gtfield"), and included in ValidationErrors as FieldError.
+//
+// Note: registration is not safe for concurrent use with validation; register validations during setup,
+// before the Validator is shared between goroutines.
+//
+// Example:
+//
+//	err := v.RegisterStructValidation(func(sl validator.StructLevel) {
+//	    r := sl.Current().Interface().(DateRange)
+//	    if !r.End.After(r.Start) {
+//	        sl.ReportError(r.End, "end", "End", "gtfield", "start")
+//	    }
+//	}, DateRange{})
+func (v *Validator) RegisterStructValidation(fn StructLevelFunc, types ...interface{}) error {
+	if err := registerStructValidation(v.validate, fn, types...); err != nil {
+		return fmt.Errorf("failed to register struct validation: %w", err)
 	}
+	return nil
+}
+
+// HasValidator reports whether a validation function is registered on this Validator for the given tag,
+// including both built-in tags (e.g., "required") and custom validators registered with WithCustomValidator
+// or RegisterValidator.
+func (v *Validator) HasValidator(tag string) bool {
+	if _, ok := v.customTags[tag]; ok {
+		return true
+	}
+	_, ok := builtinTags[tag]
+	return ok
 }
 
 // ValidateStruct validates the provided struct using the validator instance.
@@ -120,7 +215,7 @@ func (v *Validator) ValidateStruct(s interface{}) error {
 		if ve, ok := err.(validator.ValidationErrors); ok {
 			errMsgs := make([]string, len(ve))
 			for i, fe := range ve {
-				errMsgs[i] = fe.Translate(v.translator)
+				errMsgs[i] = v.translateFieldError(fe)
 			}
 			return errors.New(strings.Join(errMsgs, ", "))
 		}
@@ -147,7 +242,7 @@ func (v *Validator) Struct(s interface{}) error {
 		if ve, ok := err.(validator.ValidationErrors); ok {
 			errMsgs := make([]string, len(ve))
 			for i, fe := range ve {
-				errMsgs[i] = fe.Translate(v.translator)
+				errMsgs[i] = v.translateFieldError(fe)
 			}
 			return errors.New(strings.Join(errMsgs, ", "))
 		}
@@ -155,3 +250,120 @@ func (v *Validator) Struct(s interface{}) error {
 	}
 	return nil
 }
+
+// FieldError describes a single field-level validation failure.
+type FieldError struct {
+	Field   string `json:"field"`           // Path of the failing field (e.g., "address.city"), without the root struct name.
+	Tag     string `json:"tag"`             // Validation tag that failed (e.g., "required").
+	Param   string `json:"param,omitempty"` // Parameter of the validation tag, if any (e.g., "130" for "lte=130").
+	Message string `json:"message"`         // Translated, human-readable error message.
+}
+
+// ValidationErrors is a collection of FieldError returned by ValidateStructDetailed.
+// It implements the error interface, joining all translated messages with ", ".
+type ValidationErrors []FieldError
+
+func (ve ValidationErrors) Error() string {
+	errMsgs := make([]string, len(ve))
+	for i, fe := range ve {
+		errMsgs[i] = fe.Message
+	}
+	return strings.Join(errMsgs, ", ")
+}
+
+// ValidateStructDetailed validates the provided struct and, on failure, returns ValidationErrors
+// describing each failing field instead of a single joined message.
+// Errors that are not validation failures (e.g., passing a non-struct value) are returned as-is.
+//
+// Example:
+//
+//	err := v.ValidateStructDetailed(myStruct)
+//	var ve validator.ValidationErrors
+//	if errors.As(err, &ve) {
+//	    for _, fe := range ve {
+//	        fmt.Println(fe.Field, fe.Message)
+//	    }
+//	}
+func (v *Validator) ValidateStructDetailed(s interface{}) error {
+	if err := v.validate.Struct(s); err != nil {
+		if ve, ok := err.(validator.ValidationErrors); ok {
+			fieldErrs := make(ValidationErrors, len(ve))
+			for i, fe := range ve {
+				fieldErrs[i] = FieldError{
+					Field:   FieldPath(fe),
+					Tag:     fe.Tag(),
+					Param:   fe.Param(),
+					Message: v.translateFieldError(fe),
+				}
+			}
+			return fieldErrs
+		}
+		return err
+	}
+	return nil
+}
+
+// ToMap returns the validation errors as a map of field path to translated message.
+// If a field has multiple errors, the first one is kept.
+func (ve ValidationErrors) ToMap() map[string]string {
+	m := make(map[string]string, len(ve))
+	for _, fe := range ve {
+		if _, exists := m[fe.Field]; !exists {
+			m[fe.Field] = fe.Message
+		}
+	}
+	return m
+}
+
+// ValidateStructToMap validates the provided struct and returns the validation errors as a map of
+// field path to translated message, which is convenient for API error responses.
+// It returns a nil map and nil error if the struct is valid. Errors that are not validation failures
+// (e.g., passing a non-struct value) are returned as the error with a nil map.
+//
+// Example:
+//
+//	fieldErrs, err := v.ValidateStructToMap(myStruct)
+//	if err != nil {
+//	    // Handle unexpected error
+//	}
+//	if len(fieldErrs) > 0 {
+//	    // e.g., {"email": "email must be a valid email address"}
+//	}
+func (v *Validator) ValidateStructToMap(s interface{}) (map[string]string, error) {
+	err := v.ValidateStructDetailed(s)
+	if err == nil {
+		return nil, nil
+	}
+	var ve ValidationErrors
+	if errors.As(err, &ve) {
+		return ve.ToMap(), nil
+	}
+	return nil, err
+}
+
+// translateFieldError translates the field error using the translator.
+// For fields inside a slice, array, or map (validated with `dive`), the field name leading the message is replaced
+// with the full field path, so the failing element's index or key is not lost (e.g., "items[1].name is a required field").
+// Messages that do not start with the field name (e.g., from a custom translation) are left unchanged.
+func (v *Validator) translateFieldError(fe validator.FieldError) string {
+	msg := fe.Translate(v.translator)
+	path := FieldPath(fe)
+	if !strings.Contains(path, "[") || path == fe.Field() {
+		return msg
+	}
+	if rest, ok := strings.CutPrefix(msg, fe.Field()); ok && (rest == "" || rest[0] == ' ') {
+		return path + rest
+	}
+	return msg
+}
+
+// FieldPath returns the path of the failing field: the namespace of the field error without the leading root
+// struct name (e.g., "items[1].name" for "Order.items[1].name"). It is the path used by ValidationErrors and
+// ValidateStructToMap, and can be used to report errors of a raw go-playground validator the same way.
+func FieldPath(fe validator.FieldError) string {
+	ns := fe.Namespace()
+	if idx := strings.Index(ns, "."); idx >= 0 {
+		return ns[idx+1:]
+	}
+	return fe.Field()
+}
@@ -1,7 +1,9 @@
 package validator_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	ut "github.com/go-playground/universal-translator"
 	validatorV10 "github.com/go-playground/validator/v10"
@@ -221,3 +223,325 @@ func TestWithTagNameFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStructDetailed(t *testing.T) {
+	v, err := validator.NewValidator(
+		validator.WithTagNameFunc(validator.JSONTagNameFunc),
+	)
+	assert.NoError(t, err)
+
+	type Address struct {
+		City string `json:"city" validate:"required"`
+	}
+	type TestStruct struct {
+		FullName string  `json:"full_name" validate:"required"`
+		Age      int     `json:"age" validate:"gte=0,lte=130"`
+		Address  Address `json:"address"`
+	}
+
+	t.Run("valid struct", func(t *testing.T) {
+		err := v.ValidateStructDetailed(TestStruct{FullName: "John Doe", Age: 30, Address: Address{City: "Bangkok"}})
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid struct returns field errors", func(t *testing.T) {
+		err := v.ValidateStructDetailed(TestStruct{Age: 150})
+		assert.Error(t, err)
+
+		var ve validator.ValidationErrors
+		assert.True(t, errors.As(err, &ve), "error should be ValidationErrors")
+		assert.Equal(t, validator.ValidationErrors{
+			{Field: "full_name", Tag: "required", Message: "full_name is a required field"},
+			{Field: "age", Tag: "lte", Param: "130", Message: "age must be 130 or less"},
+			{Field: "address.city", Tag: "required", Message: "city is a required field"},
+		}, ve)
+		assert.Equal(t, "full_name is a required field, age must be 130 or less, city is a required field", err.Error())
+	})
+
+	t.Run("non-struct input returns original error", func(t *testing.T) {
+		err := v.ValidateStructDetailed("not a struct")
+		assert.Error(t, err)
+
+		var ve validator.ValidationErrors
+		assert.False(t, errors.As(err, &ve), "error should not be ValidationErrors")
+	})
+}
+
+type alwaysFailValidator struct{}
+
+func (alwaysFailValidator) Tag() string {
+	return "alwaysfail"
+}
+
+func (alwaysFailValidator) Func() validatorV10.Func {
+	return func(validatorV10.FieldLevel) bool {
+		return false
+	}
+}
+
+func (alwaysFailValidator) Translation() (string, validatorV10.TranslationFunc) {
+	customTransFunc := func(ut ut.Translator, fe validatorV10.FieldError) string {
+		t, _ := ut.T(fe.Tag(), fe.Field())
+		return t
+	}
+	return "{0} always fails", customTransFunc
+}
+
+func TestRegisterValidator(t *testing.T) {
+	v, err := validator.NewValidator()
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Field string `validate:"alwaysfail"`
+	}
+
+	assert.True(t, v.HasValidator("required"), "built-in validator should be registered")
+	assert.False(t, v.HasValidator("alwaysfail"), "custom validator should not be registered yet")
+	assert.False(t, v.HasValidator(""), "empty tag should not be registered")
+
+	// Register the custom validator after construction.
+	err = v.RegisterValidator(alwaysFailValidator{})
+	assert.NoError(t, err)
+	assert.True(t, v.HasValidator("alwaysfail"), "custom validator should be registered")
+
+	err = v.ValidateStruct(TestStruct{Field: "value"})
+	assert.Error(t, err)
+	assert.Equal(t, "Field always fails", err.Error())
+
+	err = v.RegisterValidator(nil)
+	assert.Error(t, err)
+}
+
+func TestHasValidator(t *testing.T) {
+	v, err := validator.NewValidator(validator.WithCustomValidator(new(MockCustomValidator)))
+	assert.NoError(t, err)
+
+	assert.True(t, v.HasValidator("mock"), "validator registered with WithCustomValidator should be reported")
+	assert.True(t, v.HasValidator("email"), "built-in validator should be reported")
+	assert.True(t, v.HasValidator("iscolor"), "built-in alias should be reported")
+	assert.False(t, v.HasValidator("alwaysfail"), "unknown tag should not be reported")
+	assert.False(t, v.HasValidator(""), "empty tag should not be reported")
+
+	// Custom tags are only reported by the Validator they were registered on.
+	other, err := validator.NewValidator(validator.WithCustomValidator(alwaysFailValidator{}))
+	assert.NoError(t, err)
+	assert.True(t, other.HasValidator("alwaysfail"))
+	assert.False(t, other.HasValidator("mock"), "custom tag of another validator should not be reported")
+	assert.False(t, v.HasValidator("alwaysfail"), "custom tag of another validator should not be reported")
+}
+
+func TestValidateStruct_Dive(t *testing.T) {
+	v, err := validator.NewValidator(
+		validator.WithTagNameFunc(validator.JSONTagNameFunc),
+	)
+	assert.NoError(t, err)
+
+	type Item struct {
+		Name     string `json:"name" validate:"required"`
+		Quantity int    `json:"quantity" validate:"gte=1"`
+	}
+	type Order struct {
+		Items  []Item            `json:"items" validate:"required,dive"`
+		Tags   []string          `json:"tags" validate:"dive,required"`
+		Labels map[string]string `json:"labels" validate:"dive,keys,required,endkeys,required"`
+	}
+
+	t.Run("valid elements", func(t *testing.T) {
+		err := v.ValidateStruct(Order{
+			Items:  []Item{{Name: "apple", Quantity: 1}},
+			Tags:   []string{"fruit"},
+			Labels: map[string]string{"color": "red"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("one slice element fails", func(t *testing.T) {
+		order := Order{
+			Items: []Item{
+				{Name: "apple", Quantity: 1},
+				{Quantity: 2},
+			},
+		}
+
+		err := v.ValidateStruct(order)
+		assert.Error(t, err)
+		assert.Equal(t, "items[1].name is a required field", err.Error())
+
+		err = v.ValidateStructDetailed(order)
+		var ve validator.ValidationErrors
+		assert.True(t, errors.As(err, &ve))
+		assert.Equal(t, validator.ValidationErrors{
+			{Field: "items[1].name", Tag: "required", Message: "items[1].name is a required field"},
+		}, ve)
+	})
+
+	t.Run("primitive slice and map elements fail", func(t *testing.T) {
+		err := v.ValidateStruct(Order{
+			Items:  []Item{{Name: "apple", Quantity: 1}},
+			Tags:   []string{"fruit", ""},
+			Labels: map[string]string{"color": ""},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "tags[1] is a required field")
+		assert.Contains(t, err.Error(), "labels[color] is a required field")
+	})
+}
+
+func TestFieldPath(t *testing.T) {
+	type Item struct {
+		Name string `json:"name" validate:"required"`
+	}
+	type Order struct {
+		ID    string `json:"id" validate:"required"`
+		Items []Item `json:"items" validate:"dive"`
+	}
+
+	raw := validatorV10.New()
+	raw.RegisterTagNameFunc(validator.JSONTagNameFunc)
+	err := raw.Struct(Order{Items: []Item{{Name: "apple"}, {}}})
+
+	var rawErrs validatorV10.ValidationErrors
+	assert.True(t, errors.As(err, &rawErrs))
+	paths := make([]string, 0, len(rawErrs))
+	for _, fe := range rawErrs {
+		paths = append(paths, validator.FieldPath(fe))
+	}
+	assert.Equal(t, []string{"id", "items[1].name"}, paths)
+}
+
+type encodeAgainValidator struct{}
+
+func (encodeAgainValidator) Tag() string {
+	return "encodeagain"
+}
+
+func (encodeAgainValidator) Func() validatorV10.Func {
+	return func(validatorV10.FieldLevel) bool {
+		return false
+	}
+}
+
+func (encodeAgainValidator) Translation() (string, validatorV10.TranslationFunc) {
+	customTransFunc := func(ut ut.Translator, fe validatorV10.FieldError) string {
+		t, _ := ut.T(fe.Tag(), fe.Field())
+		return t
+	}
+	return "Encode the {0} again", customTransFunc
+}
+
+func TestValidateStruct_DiveFieldNameInMessage(t *testing.T) {
+	v, err := validator.NewValidator(
+		validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		validator.WithCustomValidator(encodeAgainValidator{}),
+	)
+	assert.NoError(t, err)
+
+	type Item struct {
+		Field string `json:"field" validate:"required"`
+		Code  string `json:"code" validate:"encodeagain"`
+	}
+	type Order struct {
+		Items []Item `json:"items" validate:"dive"`
+	}
+
+	err = v.ValidateStructDetailed(Order{Items: []Item{{Code: "abc"}}})
+	var ve validator.ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, validator.ValidationErrors{
+		// Only the leading field name is replaced, not the later occurrence in "required field".
+		{Field: "items[0].field", Tag: "required", Message: "items[0].field is a required field"},
+		// The message does not start with the field name ("code" is also part of "Encode"), so it is left unchanged.
+		{Field: "items[0].code", Tag: "encodeagain", Message: "Encode the code again"},
+	}, ve)
+}
+
+func TestValidateStructToMap(t *testing.T) {
+	v, err := validator.NewValidator(
+		validator.WithTagNameFunc(validator.JSONTagNameFunc),
+	)
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		FullName string `json:"full_name" validate:"required"`
+		Email    string `json:"email" validate:"required,email"`
+		Age      int    `json:"age" validate:"gte=0,lte=130"`
+	}
+
+	t.Run("valid struct", func(t *testing.T) {
+		fieldErrs, err := v.ValidateStructToMap(TestStruct{FullName: "John Doe", Email: "john@example.com", Age: 30})
+		assert.NoError(t, err)
+		assert.Nil(t, fieldErrs)
+	})
+
+	t.Run("invalid struct", func(t *testing.T) {
+		fieldErrs, err := v.ValidateStructToMap(TestStruct{Email: "invalid-email", Age: 150})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"full_name": "full_name is a required field",
+			"email":     "email must be a valid email address",
+			"age":       "age must be 130 or less",
+		}, fieldErrs)
+	})
+
+	t.Run("non-struct input", func(t *testing.T) {
+		fieldErrs, err := v.ValidateStructToMap("not a struct")
+		assert.Error(t, err)
+		assert.Nil(t, fieldErrs)
+	})
+}
+
+func TestRegisterStructValidation(t *testing.T) {
+	type DateRange struct {
+		StartDate time.Time `json:"start_date" validate:"required"`
+		EndDate   time.Time `json:"end_date" validate:"required"`
+	}
+	dateRangeValidation := func(sl validator.StructLevel) {
+		r := sl.Current().Interface().(DateRange)
+		if !r.StartDate.Before(r.EndDate) {
+			sl.ReportError(r.EndDate, "end_date", "EndDate", "gtfield", "start_date")
+		}
+	}
+
+	v, err := validator.NewValidator(validator.WithTagNameFunc(validator.JSONTagNameFunc))
+	assert.NoError(t, err)
+
+	err = v.RegisterStructValidation(dateRangeValidation, DateRange{})
+	assert.NoError(t, err)
+
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("valid range", func(t *testing.T) {
+		err := v.ValidateStructDetailed(DateRange{StartDate: start, EndDate: start.AddDate(0, 0, 1)})
+		assert.NoError(t, err)
+	})
+
+	t.Run("end before start", func(t *testing.T) {
+		err := v.ValidateStructDetailed(DateRange{StartDate: start, EndDate: start.AddDate(0, 0, -1)})
+		assert.Error(t, err)
+
+		var ve validator.ValidationErrors
+		assert.True(t, errors.As(err, &ve), "error should be ValidationErrors")
+		assert.Equal(t, validator.ValidationErrors{
+			{Field: "end_date", Tag: "gtfield", Param: "start_date", Message: "end_date must be greater than start_date"},
+		}, ve)
+	})
+
+	t.Run("registered with option", func(t *testing.T) {
+		v, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+			validator.WithStructValidation(dateRangeValidation, DateRange{}),
+		)
+		assert.NoError(t, err)
+
+		err = v.ValidateStruct(DateRange{StartDate: start, EndDate: start})
+		assert.EqualError(t, err, "end_date must be greater than start_date")
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		assert.Error(t, v.RegisterStructValidation(nil, DateRange{}))
+		assert.Error(t, v.RegisterStructValidation(dateRangeValidation))
+
+		_, err := validator.NewValidator(validator.WithStructValidation(nil, DateRange{}))
+		assert.Error(t, err)
+	})
+}
@@ -20,7 +20,7 @@ func main() {
 	////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 	// Example: HMAC-based token creation and validation
-	signingKey := []byte("super-secret-key")
+	signingKey := []byte("super-secret-key-at-least-32-bytes")
 	manager, err := jwtutil.NewJWTManager(jwtutil.HS256, signingKey)
 	if err != nil {
 		log.Fatalf("Failed to create JWTManager: %v", err)
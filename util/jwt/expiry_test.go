@@ -0,0 +1,132 @@
+package jwt_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	jwtutil "github.com/kittipat1413/go-common/util/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenExpiry(t *testing.T) {
+	manager, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("mysecretkey-at-least-32-bytes-long"))
+	require.NoError(t, err)
+
+	t.Run("Token expiring in the future", func(t *testing.T) {
+		tokenStr, err := manager.CreateToken(context.Background(), &jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(10 * time.Minute)),
+		})
+		require.NoError(t, err)
+
+		remaining, err := manager.TimeUntilExpiry(tokenStr)
+		require.NoError(t, err)
+		require.Greater(t, remaining, 9*time.Minute)
+		require.LessOrEqual(t, remaining, 10*time.Minute)
+
+		expired, err := manager.IsExpired(tokenStr)
+		require.NoError(t, err)
+		require.False(t, expired)
+	})
+
+	t.Run("Token already expired", func(t *testing.T) {
+		tokenStr, err := manager.CreateToken(context.Background(), &jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+		})
+		require.NoError(t, err)
+
+		remaining, err := manager.TimeUntilExpiry(tokenStr)
+		require.NoError(t, err)
+		require.Less(t, remaining, time.Duration(0))
+
+		expired, err := manager.IsExpired(tokenStr)
+		require.NoError(t, err)
+		require.True(t, expired)
+	})
+
+	t.Run("Token without exp", func(t *testing.T) {
+		tokenStr, err := manager.CreateToken(context.Background(), &jwt.RegisteredClaims{Issuer: "no-exp"})
+		require.NoError(t, err)
+
+		_, err = manager.TimeUntilExpiry(tokenStr)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrMissingExpiration))
+
+		_, err = manager.IsExpired(tokenStr)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrMissingExpiration))
+	})
+
+	t.Run("Token with invalid signature", func(t *testing.T) {
+		otherManager, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("anothersecretkey-at-least-32-bytes"))
+		require.NoError(t, err)
+		tokenStr, err := otherManager.CreateToken(context.Background(), &jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(10 * time.Minute)),
+		})
+		require.NoError(t, err)
+
+		_, err = manager.TimeUntilExpiry(tokenStr)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrTokenSignatureInvalid))
+
+		_, err = manager.IsExpired(tokenStr)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrTokenSignatureInvalid))
+	})
+
+	t.Run("Malformed token", func(t *testing.T) {
+		_, err := manager.TimeUntilExpiry("not-a-token")
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrTokenMalformed))
+
+		_, err = manager.IsExpired("not-a-token")
+		require.Error(t, err)
+	})
+}
+
+func TestTokenExpiry_WithVerificationKeyResolver(t *testing.T) {
+	type ctxKey struct{}
+	tenantKeys := map[string][]byte{"tenant-1": []byte("tenant-1-secret-at-least-32-bytes-long")}
+	var resolverCtxValue interface{}
+	manager, err := jwtutil.NewJWTManager(jwtutil.HS256, nil,
+		jwtutil.WithSigningKeyResolver(func(ctx context.Context, claims jwt.Claims) (interface{}, error) {
+			return tenantKeys[claims.(*TenantClaims).TenantID], nil
+		}),
+		jwtutil.WithVerificationKeyResolver(func(ctx context.Context, header map[string]interface{}, claims jwt.Claims) (interface{}, error) {
+			resolverCtxValue = ctx.Value(ctxKey{})
+			tenantClaims, ok := claims.(*TenantClaims)
+			if !ok {
+				return nil, fmt.Errorf("unexpected claims type %T", claims)
+			}
+			return tenantKeys[tenantClaims.TenantID], nil
+		}),
+	)
+	require.NoError(t, err)
+
+	tokenStr, err := manager.CreateToken(context.Background(), &TenantClaims{
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(10 * time.Minute))},
+		TenantID:         "tenant-1",
+	})
+	require.NoError(t, err)
+
+	t.Run("TimeUntilExpiryWithClaims passes the caller's context and claims", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), ctxKey{}, "request-ctx")
+		claims := &TenantClaims{}
+		remaining, err := manager.TimeUntilExpiryWithClaims(ctx, tokenStr, claims)
+		require.NoError(t, err)
+		require.Greater(t, remaining, 9*time.Minute)
+		require.Equal(t, "tenant-1", claims.TenantID)
+		require.Equal(t, "request-ctx", resolverCtxValue)
+	})
+
+	t.Run("TimeUntilExpiry passes registered claims", func(t *testing.T) {
+		_, err := manager.TimeUntilExpiry(tokenStr)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrTokenSignatureInvalid))
+		require.Contains(t, err.Error(), "*jwt.RegisteredClaims")
+		require.Nil(t, resolverCtxValue)
+	})
+}
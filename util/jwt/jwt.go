@@ -2,8 +2,10 @@ package jwt
 
 import (
 	"context"
+	"crypto/rsa"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
@@ -18,6 +20,18 @@ type JWTManager interface {
 	// The user must pass a pointer to a claims struct (e.g., `&MyCustomClaims{}` or `&jwt.RegisteredClaims{}`)
 	// that implements `jwt.Claims`. The function validates the token and populates the provided struct.
 	ParseAndValidateToken(ctx context.Context, tokenString string, claims jwt.Claims) error
+
+	// TimeUntilExpiry returns the remaining lifetime of the token based on its `exp` claim,
+	// which is negative if the token has already expired. The token's signature is verified,
+	// but an expired token is not an error.
+	TimeUntilExpiry(tokenString string) (time.Duration, error)
+
+	// TimeUntilExpiryWithClaims is like TimeUntilExpiry, but decodes the token into the provided claims struct and
+	// passes ctx and the claims to the verification key resolver, as ParseAndValidateToken does.
+	TimeUntilExpiryWithClaims(ctx context.Context, tokenString string, claims jwt.Claims) (time.Duration, error)
+
+	// IsExpired reports whether the token's `exp` claim is in the past. The token's signature is verified.
+	IsExpired(tokenString string) (bool, error)
 }
 
 // SupportedSigningMethod defines the supported JWT signing methods for token creation and validation.
@@ -48,30 +62,133 @@ type jwtManager struct {
 	// - For HMAC-based algorithms (e.g., HS256), it is the shared secret key.
 	// - For RSA-based algorithms (e.g., RS256), it is the PEM-encoded private key.
 	signingKey []byte
+
+	// requiredClaims lists claim keys that must be present and non-empty in every validated token.
+	requiredClaims []string
+
+	// keyID is the key ID ("kid") stamped in the header of created tokens, identifying the signing key.
+	keyID string
+
+	// tokenHeaders are additional header fields stamped in the header of created tokens.
+	tokenHeaders map[string]interface{}
+
+	// hmacVerificationKeys maps key IDs to additional HMAC secrets accepted during validation (e.g., rotated-out secrets).
+	hmacVerificationKeys map[string][]byte
+
+	// claimValidators are custom checks run on the claims of every validated token.
+	claimValidators []ClaimValidator
+
+	// signingKeyResolver, if set, selects the signing key of every created token.
+	signingKeyResolver SigningKeyResolver
+
+	// verificationKeyResolver, if set, selects the verification key of every validated token.
+	verificationKeyResolver VerificationKeyResolver
+
+	// rsaPublicKey, if set, is the RSA public key used to verify tokens instead of the one derived from the signing key.
+	rsaPublicKey *rsa.PublicKey
+
+	// allowWeakKey disables the minimum length check for HMAC secrets.
+	allowWeakKey bool
 }
 
+// Sentinel errors returned (wrapped) by ParseAndValidateToken, so callers can choose the right response with errors.Is
+// (e.g., prompt a token refresh on ErrTokenExpired). The underlying jwt library error remains in the chain.
+var (
+	// ErrTokenMalformed indicates that the token could not be decoded (e.g., not three segments or invalid base64/JSON).
+	ErrTokenMalformed = errors.New("token is malformed")
+	// ErrTokenSignatureInvalid indicates that the token signature could not be verified, including when the token uses
+	// an unexpected signing method or an unknown key ID.
+	ErrTokenSignatureInvalid = errors.New("token signature is invalid")
+	// ErrTokenExpired indicates that the token's `exp` claim is in the past.
+	ErrTokenExpired = errors.New("token is expired")
+	// ErrTokenNotValidYet indicates that the token's `nbf` claim is in the future.
+	ErrTokenNotValidYet = errors.New("token is not valid yet")
+)
+
+// ErrWeakKey is returned (wrapped) by NewJWTManager when an HMAC secret is shorter than the digest size
+// of the signing method (e.g., 32 bytes for HS256) and WithAllowWeakKey is not set.
+var ErrWeakKey = errors.New("HMAC key is too short")
+
+// ErrMissingExpiration is returned (wrapped) by TimeUntilExpiry and IsExpired when the token has no `exp` claim.
+var ErrMissingExpiration = errors.New("token has no expiration")
+
+// ErrMissingRequiredClaim is returned (wrapped) by ParseAndValidateToken when a claim configured
+// with WithRequiredClaims is missing or empty.
+var ErrMissingRequiredClaim = errors.New("missing required claim")
+
 // NewJWTManager initializes a new JWT manager with the given signing method and key.
 //
 // Params:
 //   - signingMethod: The algorithm used for signing and validating JWT tokens (e.g., HS256, RS256).
 //   - signingKey: The cryptographic key used for signing and verifying tokens (e.g., shared secret, PEM-encoded private key).
-func NewJWTManager(signingMethod SupportedSigningMethod, signingKey []byte) (JWTManager, error) {
+//   - opts: Optional settings (e.g., WithRequiredClaims, WithClaimValidator).
+func NewJWTManager(signingMethod SupportedSigningMethod, signingKey []byte, opts ...Option) (JWTManager, error) {
 	if signingMethod == "" {
 		return nil, errors.New("failed to create JWT manager: missing signing method")
 	}
-	if len(signingKey) == 0 {
-		return nil, errors.New("failed to create JWT manager: missing signing key")
-	}
 
 	jwtSigningMethod, err := signingMethod.getJwtSigningMethod()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create JWT manager: %w", err)
 	}
 
-	return &jwtManager{
+	manager := &jwtManager{
 		signingMethod: jwtSigningMethod,
 		signingKey:    signingKey,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(manager)
+	}
+
+	// The signing key may only be omitted when both keys are resolved dynamically,
+	// or for a verify-only manager configured with an RSA public key.
+	if len(signingKey) == 0 && manager.rsaPublicKey == nil && (manager.signingKeyResolver == nil || manager.verificationKeyResolver == nil) {
+		return nil, errors.New("failed to create JWT manager: missing signing key")
+	}
+
+	if _, isRSA := jwtSigningMethod.(*jwt.SigningMethodRSA); manager.rsaPublicKey != nil && !isRSA {
+		return nil, errors.New("failed to create JWT manager: an RSA public key requires an RSA signing method")
+	}
+
+	if _, ok := manager.tokenHeaders["alg"]; ok {
+		return nil, errors.New("failed to create JWT manager: the alg header cannot be overridden")
+	}
+
+	hmacMethod, isHMAC := jwtSigningMethod.(*jwt.SigningMethodHMAC)
+	if len(manager.hmacVerificationKeys) > 0 {
+		if !isHMAC {
+			return nil, errors.New("failed to create JWT manager: HMAC verification keys require an HMAC signing method")
+		}
+		for kid, key := range manager.hmacVerificationKeys {
+			if kid == "" || len(key) == 0 {
+				return nil, errors.New("failed to create JWT manager: HMAC verification keys must have a non-empty key ID and secret")
+			}
+		}
+	}
+
+	if isHMAC && !manager.allowWeakKey {
+		if len(signingKey) > 0 {
+			if err := validateHMACKeyStrength(hmacMethod, signingKey); err != nil {
+				return nil, fmt.Errorf("failed to create JWT manager: signing key: %w", err)
+			}
+		}
+		for kid, key := range manager.hmacVerificationKeys {
+			if err := validateHMACKeyStrength(hmacMethod, key); err != nil {
+				return nil, fmt.Errorf("failed to create JWT manager: verification key %q: %w", kid, err)
+			}
+		}
+	}
+	return manager, nil
+}
+
+// validateHMACKeyStrength checks that the HMAC secret is at least as long as the digest size of the signing method,
+// as recommended by RFC 7518 (e.g., 32 bytes for HS256).
+func validateHMACKeyStrength(method *jwt.SigningMethodHMAC, key []byte) error {
+	minLength := method.Hash.Size()
+	if len(key) < minLength {
+		return fmt.Errorf("%w: %s requires at least %d bytes, got %d (use WithAllowWeakKey to override)", ErrWeakKey, method.Alg(), minLength, len(key))
+	}
+	return nil
 }
 
 // CreateToken generates a signed JWT token with the provided claims.
@@ -79,6 +196,26 @@ func NewJWTManager(signingMethod SupportedSigningMethod, signingKey []byte) (JWT
 func (m *jwtManager) CreateToken(ctx context.Context, claims jwt.Claims) (string, error) {
 	// Create a new token object with the desired signing method and claims.
 	token := jwt.NewWithClaims(m.signingMethod, claims)
+	for key, value := range m.tokenHeaders {
+		token.Header[key] = value
+	}
+	if m.keyID != "" {
+		token.Header["kid"] = m.keyID
+	}
+
+	// Sign the token with the resolved key, if a resolver is configured.
+	if m.signingKeyResolver != nil {
+		key, err := m.signingKeyResolver(ctx, claims)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve signing key: %w", err)
+		}
+		return token.SignedString(key)
+	}
+
+	// A verify-only manager (configured with WithRSAPublicKey and no signing key) cannot sign tokens.
+	if len(m.signingKey) == 0 {
+		return "", errors.New("failed to create token: missing signing key (the manager is verify-only)")
+	}
 
 	// Sign the token using the configured method.
 	switch m.signingMethod.(type) {
@@ -100,18 +237,56 @@ func (m *jwtManager) CreateToken(ctx context.Context, claims jwt.Claims) (string
 // ParseAndValidateToken parses and validates the token string, populating the provided claims struct if valid.
 // If the token is invalid or the claims cannot be validated, an error is returned.
 func (m *jwtManager) ParseAndValidateToken(ctx context.Context, tokenString string, claims jwt.Claims) error {
-	keyFunc := func(token *jwt.Token) (interface{}, error) {
+	parsedToken, err := jwt.ParseWithClaims(tokenString, claims, m.keyFunc(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to parse token: %w", mapParseError(err))
+	}
+	if !parsedToken.Valid {
+		return errors.New("invalid token: token is not valid")
+	}
+	if err := m.validateRequiredClaims(tokenString); err != nil {
+		return fmt.Errorf("invalid token: %w", err)
+	}
+	for _, validator := range m.claimValidators {
+		if err := validator(claims); err != nil {
+			return fmt.Errorf("invalid token: %w", err)
+		}
+	}
+	return nil
+}
+
+// keyFunc returns the jwt.Keyfunc that checks the token's signing method and returns the key used to verify its signature.
+func (m *jwtManager) keyFunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
 		// Ensure the signing method matches the configured one.
 		if token.Method.Alg() != m.signingMethod.Alg() {
-			return nil, fmt.Errorf("unexpected signing method expected %s but got %s", m.signingMethod.Alg(), token.Method.Alg())
+			return nil, &parseError{
+				sentinel: ErrTokenSignatureInvalid,
+				err:      fmt.Errorf("unexpected signing method expected %s but got %s", m.signingMethod.Alg(), token.Method.Alg()),
+			}
+		}
+
+		// Use the resolved key, if a resolver is configured.
+		if m.verificationKeyResolver != nil {
+			key, err := m.verificationKeyResolver(ctx, token.Header, token.Claims)
+			if err != nil {
+				return nil, &parseError{
+					sentinel: ErrTokenSignatureInvalid,
+					err:      fmt.Errorf("failed to resolve verification key: %w", err),
+				}
+			}
+			return key, nil
 		}
 
 		switch m.signingMethod.(type) {
 		case *jwt.SigningMethodHMAC:
-			// HMAC: use the shared secret to verify signature.
-			return m.signingKey, nil
+			// HMAC: use the shared secret selected by the token's key ID to verify signature.
+			return m.hmacVerificationKey(token)
 		case *jwt.SigningMethodRSA:
-			// RSA: parse the public key for verification.
+			// RSA: use the configured public key, or derive it from the private key.
+			if m.rsaPublicKey != nil {
+				return m.rsaPublicKey, nil
+			}
 			privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(m.signingKey)
 			if err != nil {
 				return nil, fmt.Errorf("invalid RSA private key: %w", err)
@@ -122,13 +297,133 @@ func (m *jwtManager) ParseAndValidateToken(ctx context.Context, tokenString stri
 			return nil, fmt.Errorf("unsupported signing method for token validation: %v", m.signingMethod.Alg())
 		}
 	}
+}
 
-	parsedToken, err := jwt.ParseWithClaims(tokenString, claims, keyFunc)
+// TimeUntilExpiry returns the remaining lifetime of the token based on its `exp` claim.
+// The result is negative if the token has already expired. The token's signature is verified, but its
+// time-based claims are not validated, so an expired token returns a negative duration instead of an error.
+// Use it for scheduling (e.g., refreshing a token before it expires); use ParseAndValidateToken for authorization.
+//
+// The token is decoded into a *jwt.RegisteredClaims and a verification key resolver is called with context.Background().
+// Use TimeUntilExpiryWithClaims when the resolver needs the caller's context or claims type.
+func (m *jwtManager) TimeUntilExpiry(tokenString string) (time.Duration, error) {
+	return m.TimeUntilExpiryWithClaims(context.Background(), tokenString, &jwt.RegisteredClaims{})
+}
+
+// TimeUntilExpiryWithClaims is like TimeUntilExpiry, but decodes the token into the provided claims struct
+// (e.g., `&MyCustomClaims{}`) and passes ctx and the claims to the verification key resolver.
+func (m *jwtManager) TimeUntilExpiryWithClaims(ctx context.Context, tokenString string, claims jwt.Claims) (time.Duration, error) {
+	if _, err := jwt.ParseWithClaims(tokenString, claims, m.keyFunc(ctx), jwt.WithoutClaimsValidation()); err != nil {
+		return 0, fmt.Errorf("failed to parse token: %w", mapParseError(err))
+	}
+	expiresAt, err := claims.GetExpirationTime()
 	if err != nil {
-		return fmt.Errorf("failed to parse token: %w", err)
+		return 0, fmt.Errorf("failed to read token expiry: %w", err)
 	}
-	if !parsedToken.Valid {
-		return errors.New("invalid token: token is not valid")
+	if expiresAt == nil {
+		return 0, fmt.Errorf("failed to read token expiry: %w", ErrMissingExpiration)
+	}
+	return time.Until(expiresAt.Time), nil
+}
+
+// IsExpired reports whether the token's `exp` claim is in the past.
+// Like TimeUntilExpiry, the token's signature is verified but its time-based claims are not validated.
+func (m *jwtManager) IsExpired(tokenString string) (bool, error) {
+	remaining, err := m.TimeUntilExpiry(tokenString)
+	if err != nil {
+		return false, err
+	}
+	return remaining <= 0, nil
+}
+
+// parseError is an error from the jwt library annotated with the matching sentinel error of this package.
+// Its message is the underlying error's message; errors.Is matches both the sentinel and the underlying error.
+type parseError struct {
+	sentinel error
+	err      error
+}
+
+func (e *parseError) Error() string {
+	return e.err.Error()
+}
+
+func (e *parseError) Unwrap() []error {
+	return []error{e.sentinel, e.err}
+}
+
+// mapParseError annotates a jwt library parse error with the matching sentinel error
+// (ErrTokenMalformed, ErrTokenSignatureInvalid, ErrTokenExpired, or ErrTokenNotValidYet).
+// Errors without a matching sentinel (e.g., an invalid RSA key on the server side) are returned unchanged.
+func mapParseError(err error) error {
+	var sentinel error
+	switch {
+	case errors.Is(err, ErrTokenSignatureInvalid):
+		return err // Already annotated by the key function (unexpected signing method or unknown key ID).
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		sentinel = ErrTokenMalformed
+	case errors.Is(err, jwt.ErrTokenSignatureInvalid):
+		sentinel = ErrTokenSignatureInvalid
+	case errors.Is(err, jwt.ErrTokenExpired):
+		sentinel = ErrTokenExpired
+	case errors.Is(err, jwt.ErrTokenNotValidYet):
+		sentinel = ErrTokenNotValidYet
+	default:
+		return err
+	}
+	return &parseError{sentinel: sentinel, err: err}
+}
+
+// hmacVerificationKey selects the HMAC secret used to verify the token.
+// Tokens without a key ID, or with the manager's own key ID, are verified with the signing key;
+// other key IDs are looked up in the configured HMAC verification keys.
+// If neither WithKeyID nor WithHMACVerificationKeys is configured, the key ID is ignored.
+func (m *jwtManager) hmacVerificationKey(token *jwt.Token) (interface{}, error) {
+	if m.keyID == "" && len(m.hmacVerificationKeys) == 0 {
+		return m.signingKey, nil
+	}
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" || kid == m.keyID {
+		return m.signingKey, nil
+	}
+	if key, ok := m.hmacVerificationKeys[kid]; ok {
+		return key, nil
+	}
+	return nil, &parseError{sentinel: ErrTokenSignatureInvalid, err: fmt.Errorf("unknown key ID: %s", kid)}
+}
+
+// validateRequiredClaims checks that every required claim is present and non-empty in the token payload.
+// The token must already have been verified; the payload is decoded into MapClaims so that the check
+// works regardless of the claims type supplied by the caller.
+func (m *jwtManager) validateRequiredClaims(tokenString string) error {
+	if len(m.requiredClaims) == 0 {
+		return nil
+	}
+
+	mapClaims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(tokenString, mapClaims); err != nil {
+		return fmt.Errorf("failed to decode claims: %w", err)
+	}
+
+	for _, key := range m.requiredClaims {
+		if isEmptyClaim(mapClaims[key]) {
+			return fmt.Errorf("%w: %s", ErrMissingRequiredClaim, key)
+		}
 	}
 	return nil
 }
+
+// isEmptyClaim reports whether a decoded claim value is missing or empty.
+func isEmptyClaim(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
@@ -2,6 +2,7 @@ package jwt_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -57,7 +58,7 @@ func TestJWTManager(t *testing.T) {
 		})
 
 		t.Run("HS256 Success", func(t *testing.T) {
-			mgr, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("mysecretkey"))
+			mgr, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("mysecretkey-at-least-32-bytes-long"))
 			require.NoError(t, err)
 			require.NotNil(t, mgr)
 		})
@@ -71,7 +72,7 @@ func TestJWTManager(t *testing.T) {
 
 	t.Run("TestCreateToken", func(t *testing.T) {
 		t.Run("HS256 Success", func(t *testing.T) {
-			hsManager, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("mysecretkey"))
+			hsManager, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("mysecretkey-at-least-32-bytes-long"))
 			require.NoError(t, err)
 			require.NotNil(t, hsManager)
 
@@ -121,7 +122,7 @@ func TestJWTManager(t *testing.T) {
 
 	t.Run("TestParseAndValidateToken", func(t *testing.T) {
 		// Setup HS256 manager
-		hsManager, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("mysecretkey"))
+		hsManager, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("mysecretkey-at-least-32-bytes-long"))
 		require.NoError(t, err)
 		require.NotNil(t, hsManager)
 
@@ -152,7 +153,7 @@ func TestJWTManager(t *testing.T) {
 
 		t.Run("HS256 Invalid Token (bad signature)", func(t *testing.T) {
 			// Create a token with a different key
-			otherManager, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("another-secret"))
+			otherManager, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("another-secret-at-least-32-bytes-long"))
 			require.NoError(t, err)
 
 			claims := &jwt.RegisteredClaims{Issuer: "hs256-issuer-bad"}
@@ -242,3 +243,83 @@ o8LHst+W0b6dArnxPdzILlVMFbSD
 		})
 	})
 }
+
+func TestParseAndValidateToken_ErrorKinds(t *testing.T) {
+	ctx := context.Background()
+	manager, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("mysecretkey-at-least-32-bytes-long"))
+	require.NoError(t, err)
+
+	sentinels := []error{
+		jwtutil.ErrTokenMalformed,
+		jwtutil.ErrTokenSignatureInvalid,
+		jwtutil.ErrTokenExpired,
+		jwtutil.ErrTokenNotValidYet,
+	}
+	requireOnly := func(t *testing.T, err error, expected error) {
+		require.Error(t, err)
+		for _, sentinel := range sentinels {
+			require.Equal(t, sentinel == expected, errors.Is(err, sentinel), "errors.Is(err, %q)", sentinel)
+		}
+	}
+
+	t.Run("Malformed", func(t *testing.T) {
+		err := manager.ParseAndValidateToken(ctx, "not-a-token", &jwt.RegisteredClaims{})
+		requireOnly(t, err, jwtutil.ErrTokenMalformed)
+		require.True(t, errors.Is(err, jwt.ErrTokenMalformed), "underlying jwt error should be preserved")
+	})
+
+	t.Run("Signature invalid", func(t *testing.T) {
+		otherManager, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("another-secret-at-least-32-bytes-long"))
+		require.NoError(t, err)
+		tokenStr, err := otherManager.CreateToken(ctx, &jwt.RegisteredClaims{})
+		require.NoError(t, err)
+
+		err = manager.ParseAndValidateToken(ctx, tokenStr, &jwt.RegisteredClaims{})
+		requireOnly(t, err, jwtutil.ErrTokenSignatureInvalid)
+		require.True(t, errors.Is(err, jwt.ErrTokenSignatureInvalid), "underlying jwt error should be preserved")
+	})
+
+	t.Run("Unexpected signing method", func(t *testing.T) {
+		rsManager, err := jwtutil.NewJWTManager(jwtutil.RS256, []byte(validRSAPrivateKey))
+		require.NoError(t, err)
+		tokenStr, err := rsManager.CreateToken(ctx, &jwt.RegisteredClaims{})
+		require.NoError(t, err)
+
+		err = manager.ParseAndValidateToken(ctx, tokenStr, &jwt.RegisteredClaims{})
+		requireOnly(t, err, jwtutil.ErrTokenSignatureInvalid)
+		require.Contains(t, err.Error(), "unexpected signing method expected HS256 but got RS256")
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		tokenStr, err := manager.CreateToken(ctx, &jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+		})
+		require.NoError(t, err)
+
+		err = manager.ParseAndValidateToken(ctx, tokenStr, &jwt.RegisteredClaims{})
+		requireOnly(t, err, jwtutil.ErrTokenExpired)
+		require.True(t, errors.Is(err, jwt.ErrTokenExpired), "underlying jwt error should be preserved")
+	})
+
+	t.Run("Not valid yet", func(t *testing.T) {
+		tokenStr, err := manager.CreateToken(ctx, &jwt.RegisteredClaims{
+			NotBefore: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		})
+		require.NoError(t, err)
+
+		err = manager.ParseAndValidateToken(ctx, tokenStr, &jwt.RegisteredClaims{})
+		requireOnly(t, err, jwtutil.ErrTokenNotValidYet)
+	})
+
+	t.Run("Server-side key error is not mapped", func(t *testing.T) {
+		rsManager, err := jwtutil.NewJWTManager(jwtutil.RS256, []byte(validRSAPrivateKey))
+		require.NoError(t, err)
+		rsInvalidManager, err := jwtutil.NewJWTManager(jwtutil.RS256, []byte(invalidRSAPrivateKey))
+		require.NoError(t, err)
+		tokenStr, err := rsManager.CreateToken(ctx, &jwt.RegisteredClaims{})
+		require.NoError(t, err)
+
+		err = rsInvalidManager.ParseAndValidateToken(ctx, tokenStr, &jwt.RegisteredClaims{})
+		requireOnly(t, err, nil)
+	})
+}
@@ -0,0 +1,113 @@
+package jwt
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// LoadHMACKeyFromBase64 decodes a base64-encoded HMAC secret.
+// Both the URL-safe and standard alphabets are accepted, with or without padding,
+// so secrets can be supplied as single-line values (e.g., from environment variables).
+// The returned key can be passed directly to NewJWTManager with HS256.
+func LoadHMACKeyFromBase64(s string) ([]byte, error) {
+	key, err := decodeBase64(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load HMAC key: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, errors.New("failed to load HMAC key: key is empty")
+	}
+	return key, nil
+}
+
+// LoadRSAPrivateKeyFromBase64DER decodes a base64-encoded DER RSA private key (PKCS#1 or PKCS#8)
+// and returns it PEM-encoded, so it can be passed directly to NewJWTManager with RS256.
+func LoadRSAPrivateKeyFromBase64DER(s string) ([]byte, error) {
+	der, err := decodeBase64(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load RSA private key: %w", err)
+	}
+	privateKey, err := parseRSAPrivateKeyFromDER(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load RSA private key: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	}), nil
+}
+
+// LoadRSAPrivateKeyFromBase64PEM decodes a base64-encoded PEM RSA private key and returns the PEM bytes,
+// so it can be passed directly to NewJWTManager with RS256. The key is parsed to ensure it is valid.
+func LoadRSAPrivateKeyFromBase64PEM(s string) ([]byte, error) {
+	pemBytes, err := decodeBase64(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load RSA private key: %w", err)
+	}
+	if _, err := jwt.ParseRSAPrivateKeyFromPEM(pemBytes); err != nil {
+		return nil, fmt.Errorf("failed to load RSA private key: %w", err)
+	}
+	return pemBytes, nil
+}
+
+// LoadRSAPublicKeyFromBase64DER decodes a base64-encoded DER RSA public key (PKIX or PKCS#1).
+// It is useful for services that only need to verify tokens issued elsewhere: pass the key to NewJWTManager
+// with WithRSAPublicKey to create a verify-only manager.
+func LoadRSAPublicKeyFromBase64DER(s string) (*rsa.PublicKey, error) {
+	der, err := decodeBase64(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load RSA public key: %w", err)
+	}
+	if key, err := x509.ParsePKIXPublicKey(der); err == nil {
+		publicKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return nil, errors.New("failed to load RSA public key: key is not an RSA public key")
+		}
+		return publicKey, nil
+	}
+	publicKey, err := x509.ParsePKCS1PublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load RSA public key: %w", err)
+	}
+	return publicKey, nil
+}
+
+// parseRSAPrivateKeyFromDER parses a DER-encoded RSA private key in either PKCS#1 or PKCS#8 form.
+func parseRSAPrivateKeyFromDER(der []byte) (*rsa.PrivateKey, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("key is not an RSA private key")
+	}
+	return privateKey, nil
+}
+
+// decodeBase64 decodes s using the URL-safe or standard base64 alphabet, with or without padding.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	encodings := []*base64.Encoding{
+		base64.RawURLEncoding,
+		base64.URLEncoding,
+		base64.RawStdEncoding,
+		base64.StdEncoding,
+	}
+	for _, enc := range encodings {
+		if decoded, err := enc.DecodeString(s); err == nil {
+			return decoded, nil
+		}
+	}
+	return nil, errors.New("invalid base64 string")
+}
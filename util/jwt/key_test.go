@@ -0,0 +1,193 @@
+package jwt_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	jwtutil "github.com/kittipat1413/go-common/util/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadHMACKeyFromBase64(t *testing.T) {
+	secret := []byte("a-very-secret-key-used-for-hs256-signing")
+
+	t.Run("URL encoding without padding", func(t *testing.T) {
+		key, err := jwtutil.LoadHMACKeyFromBase64(base64.RawURLEncoding.EncodeToString(secret))
+		require.NoError(t, err)
+		require.Equal(t, secret, key)
+	})
+
+	t.Run("Standard encoding with padding", func(t *testing.T) {
+		key, err := jwtutil.LoadHMACKeyFromBase64(base64.StdEncoding.EncodeToString(secret))
+		require.NoError(t, err)
+		require.Equal(t, secret, key)
+	})
+
+	t.Run("Invalid base64", func(t *testing.T) {
+		key, err := jwtutil.LoadHMACKeyFromBase64("not base64!!")
+		require.Error(t, err)
+		require.Nil(t, key)
+	})
+
+	t.Run("Empty key", func(t *testing.T) {
+		key, err := jwtutil.LoadHMACKeyFromBase64("")
+		require.Error(t, err)
+		require.Nil(t, key)
+	})
+
+	t.Run("Round trip sign and validate", func(t *testing.T) {
+		key, err := jwtutil.LoadHMACKeyFromBase64(base64.RawURLEncoding.EncodeToString(secret))
+		require.NoError(t, err)
+
+		manager, err := jwtutil.NewJWTManager(jwtutil.HS256, key)
+		require.NoError(t, err)
+
+		claims := &jwt.RegisteredClaims{
+			Issuer:    "base64-issuer",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}
+		tokenStr, err := manager.CreateToken(context.Background(), claims)
+		require.NoError(t, err)
+
+		parsedClaims := &jwt.RegisteredClaims{}
+		require.NoError(t, manager.ParseAndValidateToken(context.Background(), tokenStr, parsedClaims))
+		require.Equal(t, claims.Issuer, parsedClaims.Issuer)
+	})
+}
+
+func TestLoadRSAKeysFromBase64(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	pkcs1DER := x509.MarshalPKCS1PrivateKey(privateKey)
+	pkcs8DER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+
+	signAndVerify := func(t *testing.T, pemKey []byte) {
+		manager, err := jwtutil.NewJWTManager(jwtutil.RS256, pemKey)
+		require.NoError(t, err)
+
+		claims := &jwt.RegisteredClaims{
+			Issuer:    "base64-rs256-issuer",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}
+		tokenStr, err := manager.CreateToken(context.Background(), claims)
+		require.NoError(t, err)
+
+		parsedClaims := &jwt.RegisteredClaims{}
+		require.NoError(t, manager.ParseAndValidateToken(context.Background(), tokenStr, parsedClaims))
+		require.Equal(t, claims.Issuer, parsedClaims.Issuer)
+
+		// The token must also verify with a verify-only manager using the public key loaded from base64 DER.
+		publicKey, err := jwtutil.LoadRSAPublicKeyFromBase64DER(base64.RawURLEncoding.EncodeToString(publicDER))
+		require.NoError(t, err)
+		verifier, err := jwtutil.NewJWTManager(jwtutil.RS256, nil, jwtutil.WithRSAPublicKey(publicKey))
+		require.NoError(t, err)
+		verifiedClaims := &jwt.RegisteredClaims{}
+		require.NoError(t, verifier.ParseAndValidateToken(context.Background(), tokenStr, verifiedClaims))
+		require.Equal(t, claims.Issuer, verifiedClaims.Issuer)
+	}
+
+	t.Run("PKCS1 DER private key", func(t *testing.T) {
+		pemKey, err := jwtutil.LoadRSAPrivateKeyFromBase64DER(base64.StdEncoding.EncodeToString(pkcs1DER))
+		require.NoError(t, err)
+		signAndVerify(t, pemKey)
+	})
+
+	t.Run("PKCS8 DER private key", func(t *testing.T) {
+		pemKey, err := jwtutil.LoadRSAPrivateKeyFromBase64DER(base64.RawURLEncoding.EncodeToString(pkcs8DER))
+		require.NoError(t, err)
+		signAndVerify(t, pemKey)
+	})
+
+	t.Run("Base64 PEM private key", func(t *testing.T) {
+		pemBytes := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: pkcs1DER})
+		pemKey, err := jwtutil.LoadRSAPrivateKeyFromBase64PEM(base64.StdEncoding.EncodeToString(pemBytes))
+		require.NoError(t, err)
+		require.Equal(t, pemBytes, pemKey)
+		signAndVerify(t, pemKey)
+	})
+
+	t.Run("PKCS1 DER public key", func(t *testing.T) {
+		publicKey, err := jwtutil.LoadRSAPublicKeyFromBase64DER(
+			base64.StdEncoding.EncodeToString(x509.MarshalPKCS1PublicKey(&privateKey.PublicKey)),
+		)
+		require.NoError(t, err)
+		require.True(t, privateKey.PublicKey.Equal(publicKey))
+	})
+
+	t.Run("Invalid DER private key", func(t *testing.T) {
+		pemKey, err := jwtutil.LoadRSAPrivateKeyFromBase64DER(base64.StdEncoding.EncodeToString([]byte("not-a-key")))
+		require.Error(t, err)
+		require.Nil(t, pemKey)
+	})
+
+	t.Run("Invalid PEM private key", func(t *testing.T) {
+		pemKey, err := jwtutil.LoadRSAPrivateKeyFromBase64PEM(base64.StdEncoding.EncodeToString([]byte(invalidRSAPrivateKey)))
+		require.Error(t, err)
+		require.Nil(t, pemKey)
+	})
+
+	t.Run("Invalid DER public key", func(t *testing.T) {
+		publicKey, err := jwtutil.LoadRSAPublicKeyFromBase64DER(base64.StdEncoding.EncodeToString([]byte("not-a-key")))
+		require.Error(t, err)
+		require.Nil(t, publicKey)
+	})
+}
+
+func TestWithRSAPublicKey(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	issuer, err := jwtutil.NewJWTManager(jwtutil.RS256, pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	}))
+	require.NoError(t, err)
+	tokenStr, err := issuer.CreateToken(context.Background(), &jwt.RegisteredClaims{
+		Subject:   "user-123",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	})
+	require.NoError(t, err)
+
+	t.Run("Verify-only manager validates tokens", func(t *testing.T) {
+		verifier, err := jwtutil.NewJWTManager(jwtutil.RS256, nil, jwtutil.WithRSAPublicKey(&privateKey.PublicKey))
+		require.NoError(t, err)
+
+		claims := &jwt.RegisteredClaims{}
+		require.NoError(t, verifier.ParseAndValidateToken(context.Background(), tokenStr, claims))
+		require.Equal(t, "user-123", claims.Subject)
+
+		remaining, err := verifier.TimeUntilExpiry(tokenStr)
+		require.NoError(t, err)
+		require.Greater(t, remaining, 59*time.Minute)
+
+		_, err = verifier.CreateToken(context.Background(), &jwt.RegisteredClaims{Subject: "user-123"})
+		require.Error(t, err)
+	})
+
+	t.Run("Mismatched public key", func(t *testing.T) {
+		verifier, err := jwtutil.NewJWTManager(jwtutil.RS256, nil, jwtutil.WithRSAPublicKey(&otherKey.PublicKey))
+		require.NoError(t, err)
+
+		err = verifier.ParseAndValidateToken(context.Background(), tokenStr, &jwt.RegisteredClaims{})
+		require.Error(t, err)
+		require.ErrorIs(t, err, jwtutil.ErrTokenSignatureInvalid)
+	})
+
+	t.Run("Requires an RSA signing method", func(t *testing.T) {
+		_, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("mysecretkey-at-least-32-bytes-long"), jwtutil.WithRSAPublicKey(&privateKey.PublicKey))
+		require.Error(t, err)
+	})
+}
@@ -7,6 +7,7 @@ package jwt_mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	jwt "github.com/golang-jwt/jwt/v5"
 	gomock "github.com/golang/mock/gomock"
@@ -50,6 +51,21 @@ func (mr *MockJWTManagerMockRecorder) CreateToken(ctx, claims interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockJWTManager)(nil).CreateToken), ctx, claims)
 }
 
+// IsExpired mocks base method.
+func (m *MockJWTManager) IsExpired(tokenString string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsExpired", tokenString)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsExpired indicates an expected call of IsExpired.
+func (mr *MockJWTManagerMockRecorder) IsExpired(tokenString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsExpired", reflect.TypeOf((*MockJWTManager)(nil).IsExpired), tokenString)
+}
+
 // ParseAndValidateToken mocks base method.
 func (m *MockJWTManager) ParseAndValidateToken(ctx context.Context, tokenString string, claims jwt.Claims) error {
 	m.ctrl.T.Helper()
@@ -63,3 +79,33 @@ func (mr *MockJWTManagerMockRecorder) ParseAndValidateToken(ctx, tokenString, cl
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseAndValidateToken", reflect.TypeOf((*MockJWTManager)(nil).ParseAndValidateToken), ctx, tokenString, claims)
 }
+
+// TimeUntilExpiry mocks base method.
+func (m *MockJWTManager) TimeUntilExpiry(tokenString string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeUntilExpiry", tokenString)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TimeUntilExpiry indicates an expected call of TimeUntilExpiry.
+func (mr *MockJWTManagerMockRecorder) TimeUntilExpiry(tokenString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeUntilExpiry", reflect.TypeOf((*MockJWTManager)(nil).TimeUntilExpiry), tokenString)
+}
+
+// TimeUntilExpiryWithClaims mocks base method.
+func (m *MockJWTManager) TimeUntilExpiryWithClaims(ctx context.Context, tokenString string, claims jwt.Claims) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeUntilExpiryWithClaims", ctx, tokenString, claims)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TimeUntilExpiryWithClaims indicates an expected call of TimeUntilExpiryWithClaims.
+func (mr *MockJWTManagerMockRecorder) TimeUntilExpiryWithClaims(ctx, tokenString, claims interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeUntilExpiryWithClaims", reflect.TypeOf((*MockJWTManager)(nil).TimeUntilExpiryWithClaims), ctx, tokenString, claims)
+}
@@ -0,0 +1,163 @@
+package jwt
+
+import (
+	"context"
+	"crypto/rsa"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Option configures optional behavior of the JWT manager.
+type Option func(*jwtManager)
+
+// WithRequiredClaims requires the given claim keys (e.g., "tenant_id") to be present and non-empty in every token.
+// The check runs in ParseAndValidateToken after signature and standard claims validation.
+// A missing or empty claim results in an error wrapping ErrMissingRequiredClaim.
+func WithRequiredClaims(keys ...string) Option {
+	return func(m *jwtManager) {
+		m.requiredClaims = append(m.requiredClaims, keys...)
+	}
+}
+
+// WithKeyID sets the key ID ("kid") stamped in the header of every token created by the manager.
+// During validation, tokens carrying this kid are verified with the manager's signing key.
+func WithKeyID(kid string) Option {
+	return func(m *jwtManager) {
+		m.keyID = kid
+	}
+}
+
+// WithTokenHeaders sets additional header fields (e.g., "typ", "cty", or "x5t") stamped in the header of every token
+// created by the manager. They may override the default "typ" header, but not "alg", which is determined by the
+// signing method, nor "kid" when WithKeyID is set.
+func WithTokenHeaders(headers map[string]interface{}) Option {
+	return func(m *jwtManager) {
+		if m.tokenHeaders == nil {
+			m.tokenHeaders = make(map[string]interface{}, len(headers))
+		}
+		for key, value := range headers {
+			m.tokenHeaders[key] = value
+		}
+	}
+}
+
+// WithHMACVerificationKeys adds HMAC secrets, addressed by key ID, that are accepted when validating tokens.
+// Combined with WithKeyID, it enables zero-downtime secret rotation for HS256: sign with the new secret
+// while still accepting tokens signed with previous secrets until they expire.
+// It is only valid with an HMAC signing method.
+//
+// Example:
+//
+//	manager, err := NewJWTManager(HS256, newSecret,
+//	    WithKeyID("2024-02"),
+//	    WithHMACVerificationKeys(map[string][]byte{"2024-01": oldSecret}),
+//	)
+func WithHMACVerificationKeys(keys map[string][]byte) Option {
+	return func(m *jwtManager) {
+		if m.hmacVerificationKeys == nil {
+			m.hmacVerificationKeys = make(map[string][]byte, len(keys))
+		}
+		for kid, key := range keys {
+			m.hmacVerificationKeys[kid] = key
+		}
+	}
+}
+
+// WithAllowWeakKey disables the minimum length check for HMAC secrets (the digest size, e.g., 32 bytes for HS256).
+// It is an escape hatch for existing deployments that cannot yet rotate to a strong secret; avoid it for new ones.
+func WithAllowWeakKey() Option {
+	return func(m *jwtManager) {
+		m.allowWeakKey = true
+	}
+}
+
+// ClaimValidator is a custom check on the claims of a validated token (e.g., the scope contains a value
+// or the tenant matches). It returns a non-nil error to reject the token.
+type ClaimValidator func(claims jwt.Claims) error
+
+// WithClaimValidator adds a custom claims validator. It can be given multiple times; validators run in order
+// in ParseAndValidateToken after signature, standard claims, and required claims validation, receiving the
+// claims struct passed by the caller. The first error aborts validation and is returned wrapped.
+//
+// Example:
+//
+//	manager, err := NewJWTManager(HS256, secret, WithClaimValidator(func(claims jwt.Claims) error {
+//	    if !slices.Contains(claims.(*MyClaims).Scopes, "orders:write") {
+//	        return ErrInsufficientScope
+//	    }
+//	    return nil
+//	}))
+func WithClaimValidator(validator ClaimValidator) Option {
+	return func(m *jwtManager) {
+		if validator != nil {
+			m.claimValidators = append(m.claimValidators, validator)
+		}
+	}
+}
+
+// SigningKeyResolver selects the key used to sign a token from its claims (e.g., a per-tenant secret).
+// The key must be in the form expected by the signing method: a []byte secret for HS256,
+// or an *rsa.PrivateKey for RS256.
+type SigningKeyResolver func(ctx context.Context, claims jwt.Claims) (interface{}, error)
+
+// VerificationKeyResolver selects the key used to verify a token from its header (e.g., "kid") and its
+// unverified claims (e.g., the tenant). The key must be in the form expected by the signing method:
+// a []byte secret for HS256, or an *rsa.PublicKey for RS256.
+//
+// The claims argument is the claims value the token is decoded into, already populated from the unverified payload:
+//   - In ParseAndValidateToken, it is the claims struct passed by the caller (e.g., *TenantClaims), and ctx is the caller's context.
+//   - In TimeUntilExpiryWithClaims, it is the claims struct passed by the caller, and ctx is the caller's context.
+//   - In TimeUntilExpiry and IsExpired, which take no context, it is a *jwt.RegisteredClaims and ctx is context.Background().
+//
+// A resolver shared by these methods should therefore not assume a single claims type; use a type switch or a
+// checked type assertion and return an error for unexpected types instead of panicking.
+type VerificationKeyResolver func(ctx context.Context, header map[string]interface{}, claims jwt.Claims) (interface{}, error)
+
+// WithSigningKeyResolver sets a resolver that selects the signing key for every token created by the manager,
+// instead of the manager's signing key. Combined with WithVerificationKeyResolver, the signing key passed to
+// NewJWTManager may be empty.
+func WithSigningKeyResolver(resolver SigningKeyResolver) Option {
+	return func(m *jwtManager) {
+		m.signingKeyResolver = resolver
+	}
+}
+
+// WithVerificationKeyResolver sets a resolver that selects the verification key for every validated token,
+// instead of the manager's signing key and the keys configured with WithHMACVerificationKeys.
+// The token's signing method must still match the manager's. A resolver error rejects the token
+// with an error wrapping both ErrTokenSignatureInvalid and the resolver error.
+//
+// Example:
+//
+//	manager, err := NewJWTManager(HS256, nil,
+//	    WithSigningKeyResolver(func(ctx context.Context, claims jwt.Claims) (interface{}, error) {
+//	        return secrets.ForTenant(claims.(*TenantClaims).TenantID)
+//	    }),
+//	    WithVerificationKeyResolver(func(ctx context.Context, header map[string]interface{}, claims jwt.Claims) (interface{}, error) {
+//	        tenantClaims, ok := claims.(*TenantClaims)
+//	        if !ok {
+//	            return nil, fmt.Errorf("unexpected claims type %T", claims)
+//	        }
+//	        return secrets.ForTenant(tenantClaims.TenantID)
+//	    }),
+//	)
+func WithVerificationKeyResolver(resolver VerificationKeyResolver) Option {
+	return func(m *jwtManager) {
+		m.verificationKeyResolver = resolver
+	}
+}
+
+// WithRSAPublicKey sets the RSA public key used to verify tokens (RS256 only), instead of the public key derived
+// from the signing key. It enables verify-only managers for services that validate tokens issued elsewhere:
+// the signing key passed to NewJWTManager may then be empty, in which case CreateToken returns an error.
+//
+// Example:
+//
+//	publicKey, err := LoadRSAPublicKeyFromBase64DER(os.Getenv("JWT_PUBLIC_KEY"))
+//	// handle err
+//	manager, err := NewJWTManager(RS256, nil, WithRSAPublicKey(publicKey))
+func WithRSAPublicKey(key *rsa.PublicKey) Option {
+	return func(m *jwtManager) {
+		m.rsaPublicKey = key
+	}
+}
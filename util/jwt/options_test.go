@@ -0,0 +1,421 @@
+package jwt_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	jwtutil "github.com/kittipat1413/go-common/util/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+type TenantClaims struct {
+	jwt.RegisteredClaims
+	TenantID string   `json:"tenant_id,omitempty"`
+	Roles    []string `json:"roles,omitempty"`
+}
+
+func TestWithRequiredClaims(t *testing.T) {
+	signingKey := []byte("mysecretkey-at-least-32-bytes-long")
+	issuer, err := jwtutil.NewJWTManager(jwtutil.HS256, signingKey)
+	require.NoError(t, err)
+
+	manager, err := jwtutil.NewJWTManager(jwtutil.HS256, signingKey, jwtutil.WithRequiredClaims("tenant_id", "roles"))
+	require.NoError(t, err)
+
+	registered := jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(5 * time.Minute))}
+
+	t.Run("All required claims present", func(t *testing.T) {
+		tokenStr, err := issuer.CreateToken(context.Background(), &TenantClaims{
+			RegisteredClaims: registered,
+			TenantID:         "tenant-1",
+			Roles:            []string{"admin"},
+		})
+		require.NoError(t, err)
+
+		parsedClaims := &TenantClaims{}
+		require.NoError(t, manager.ParseAndValidateToken(context.Background(), tokenStr, parsedClaims))
+		require.Equal(t, "tenant-1", parsedClaims.TenantID)
+	})
+
+	t.Run("Missing required claim", func(t *testing.T) {
+		tokenStr, err := issuer.CreateToken(context.Background(), &TenantClaims{
+			RegisteredClaims: registered,
+			Roles:            []string{"admin"},
+		})
+		require.NoError(t, err)
+
+		err = manager.ParseAndValidateToken(context.Background(), tokenStr, &TenantClaims{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrMissingRequiredClaim))
+		require.Contains(t, err.Error(), "tenant_id")
+	})
+
+	t.Run("Empty required claim with MapClaims", func(t *testing.T) {
+		tokenStr, err := issuer.CreateToken(context.Background(), jwt.MapClaims{
+			"tenant_id": "tenant-1",
+			"roles":     []string{},
+		})
+		require.NoError(t, err)
+
+		err = manager.ParseAndValidateToken(context.Background(), tokenStr, jwt.MapClaims{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrMissingRequiredClaim))
+		require.Contains(t, err.Error(), "roles")
+	})
+
+	t.Run("Standard validation runs first", func(t *testing.T) {
+		tokenStr, err := issuer.CreateToken(context.Background(), &TenantClaims{
+			RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))},
+		})
+		require.NoError(t, err)
+
+		err = manager.ParseAndValidateToken(context.Background(), tokenStr, &TenantClaims{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwt.ErrTokenExpired))
+		require.False(t, errors.Is(err, jwtutil.ErrMissingRequiredClaim))
+	})
+}
+
+type ScopeClaims struct {
+	jwt.RegisteredClaims
+	Scope []string `json:"scope,omitempty"`
+}
+
+func TestWithClaimValidator(t *testing.T) {
+	errInsufficientScope := errors.New("insufficient scope")
+	errWrongTenant := errors.New("wrong tenant")
+
+	requireScope := func(scope string) jwtutil.ClaimValidator {
+		return func(claims jwt.Claims) error {
+			scopeClaims, ok := claims.(*ScopeClaims)
+			if !ok {
+				return errors.New("unexpected claims type")
+			}
+			for _, s := range scopeClaims.Scope {
+				if s == scope {
+					return nil
+				}
+			}
+			return errInsufficientScope
+		}
+	}
+	var secondValidatorCalls int
+	requireAudience := func(claims jwt.Claims) error {
+		secondValidatorCalls++
+		audience, err := claims.GetAudience()
+		if err != nil {
+			return err
+		}
+		for _, aud := range audience {
+			if aud == "tenant-1" {
+				return nil
+			}
+		}
+		return errWrongTenant
+	}
+
+	signingKey := []byte("mysecretkey-at-least-32-bytes-long")
+	manager, err := jwtutil.NewJWTManager(jwtutil.HS256, signingKey,
+		jwtutil.WithClaimValidator(requireScope("orders:write")),
+		jwtutil.WithClaimValidator(requireAudience),
+	)
+	require.NoError(t, err)
+
+	newToken := func(t *testing.T, audience string, scope ...string) string {
+		tokenStr, err := manager.CreateToken(context.Background(), &ScopeClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Audience:  jwt.ClaimStrings{audience},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(5 * time.Minute)),
+			},
+			Scope: scope,
+		})
+		require.NoError(t, err)
+		return tokenStr
+	}
+
+	t.Run("Token with the scope", func(t *testing.T) {
+		secondValidatorCalls = 0
+		tokenStr := newToken(t, "tenant-1", "orders:read", "orders:write")
+
+		parsedClaims := &ScopeClaims{}
+		require.NoError(t, manager.ParseAndValidateToken(context.Background(), tokenStr, parsedClaims))
+		require.Equal(t, []string{"orders:read", "orders:write"}, parsedClaims.Scope)
+		require.Equal(t, 1, secondValidatorCalls)
+	})
+
+	t.Run("Token without the scope", func(t *testing.T) {
+		secondValidatorCalls = 0
+		tokenStr := newToken(t, "tenant-1", "orders:read")
+
+		err := manager.ParseAndValidateToken(context.Background(), tokenStr, &ScopeClaims{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, errInsufficientScope))
+		require.Equal(t, 0, secondValidatorCalls, "validation should stop at the first error")
+	})
+
+	t.Run("Every validator runs", func(t *testing.T) {
+		tokenStr := newToken(t, "tenant-2", "orders:write")
+
+		err := manager.ParseAndValidateToken(context.Background(), tokenStr, &ScopeClaims{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, errWrongTenant))
+	})
+
+	t.Run("Validators do not run for an invalid token", func(t *testing.T) {
+		secondValidatorCalls = 0
+		tokenStr, err := manager.CreateToken(context.Background(), &ScopeClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Audience:  jwt.ClaimStrings{"tenant-1"},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			},
+		})
+		require.NoError(t, err)
+
+		err = manager.ParseAndValidateToken(context.Background(), tokenStr, &ScopeClaims{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrTokenExpired))
+		require.Equal(t, 0, secondValidatorCalls)
+	})
+}
+
+func TestWithTokenHeaders(t *testing.T) {
+	signingKey := []byte("mysecretkey-at-least-32-bytes-long")
+	manager, err := jwtutil.NewJWTManager(jwtutil.HS256, signingKey,
+		jwtutil.WithKeyID("key-1"),
+		jwtutil.WithTokenHeaders(map[string]interface{}{
+			"typ": "at+jwt",
+			"cty": "JWT",
+			"x5t": "dGh1bWJwcmludA",
+			"kid": "ignored",
+		}),
+	)
+	require.NoError(t, err)
+
+	tokenStr, err := manager.CreateToken(context.Background(), &jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(5 * time.Minute)),
+	})
+	require.NoError(t, err)
+
+	t.Run("Headers are readable with the unverified parser", func(t *testing.T) {
+		token, _, err := jwt.NewParser().ParseUnverified(tokenStr, &jwt.RegisteredClaims{})
+		require.NoError(t, err)
+		require.Equal(t, "at+jwt", token.Header["typ"])
+		require.Equal(t, "JWT", token.Header["cty"])
+		require.Equal(t, "dGh1bWJwcmludA", token.Header["x5t"])
+		require.Equal(t, "HS256", token.Header["alg"])
+		require.Equal(t, "key-1", token.Header["kid"], "WithKeyID should take precedence")
+	})
+
+	t.Run("Token with custom headers is valid", func(t *testing.T) {
+		require.NoError(t, manager.ParseAndValidateToken(context.Background(), tokenStr, &jwt.RegisteredClaims{}))
+	})
+
+	t.Run("alg header cannot be overridden", func(t *testing.T) {
+		_, err := jwtutil.NewJWTManager(jwtutil.HS256, signingKey,
+			jwtutil.WithTokenHeaders(map[string]interface{}{"alg": "none"}),
+		)
+		require.Error(t, err)
+	})
+}
+
+func TestHMACKeyRotation(t *testing.T) {
+	secretA := []byte("secret-a-used-before-the-rotation")
+	secretB := []byte("secret-b-used-after-the-rotation")
+	claims := &jwt.RegisteredClaims{
+		Issuer:    "rotation-issuer",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(5 * time.Minute)),
+	}
+
+	// Before rotation: sign with secret A (kid=a).
+	managerA, err := jwtutil.NewJWTManager(jwtutil.HS256, secretA, jwtutil.WithKeyID("a"))
+	require.NoError(t, err)
+	oldToken, err := managerA.CreateToken(context.Background(), claims)
+	require.NoError(t, err)
+
+	oldParsed, _, err := jwt.NewParser().ParseUnverified(oldToken, &jwt.RegisteredClaims{})
+	require.NoError(t, err)
+	require.Equal(t, "a", oldParsed.Header["kid"], "token should carry the active key ID")
+
+	// After rotation: sign with secret B (kid=b) while still accepting secret A.
+	managerB, err := jwtutil.NewJWTManager(jwtutil.HS256, secretB,
+		jwtutil.WithKeyID("b"),
+		jwtutil.WithHMACVerificationKeys(map[string][]byte{"a": secretA}),
+	)
+	require.NoError(t, err)
+	newToken, err := managerB.CreateToken(context.Background(), claims)
+	require.NoError(t, err)
+
+	t.Run("Verify old token", func(t *testing.T) {
+		parsedClaims := &jwt.RegisteredClaims{}
+		require.NoError(t, managerB.ParseAndValidateToken(context.Background(), oldToken, parsedClaims))
+		require.Equal(t, claims.Issuer, parsedClaims.Issuer)
+	})
+
+	t.Run("Verify new token", func(t *testing.T) {
+		parsedClaims := &jwt.RegisteredClaims{}
+		require.NoError(t, managerB.ParseAndValidateToken(context.Background(), newToken, parsedClaims))
+		require.Equal(t, claims.Issuer, parsedClaims.Issuer)
+	})
+
+	t.Run("Reject new token on manager without the new key", func(t *testing.T) {
+		err := managerA.ParseAndValidateToken(context.Background(), newToken, &jwt.RegisteredClaims{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unknown key ID: b")
+	})
+
+	t.Run("Reject token with known key ID but wrong secret", func(t *testing.T) {
+		forger, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("attacker-controlled-secret-value"), jwtutil.WithKeyID("a"))
+		require.NoError(t, err)
+		forgedToken, err := forger.CreateToken(context.Background(), claims)
+		require.NoError(t, err)
+
+		err = managerB.ParseAndValidateToken(context.Background(), forgedToken, &jwt.RegisteredClaims{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwt.ErrTokenSignatureInvalid))
+	})
+
+	t.Run("Reject HMAC verification keys with RS256", func(t *testing.T) {
+		mgr, err := jwtutil.NewJWTManager(jwtutil.RS256, []byte(validRSAPrivateKey),
+			jwtutil.WithHMACVerificationKeys(map[string][]byte{"a": secretA}),
+		)
+		require.Error(t, err)
+		require.Nil(t, mgr)
+	})
+
+	t.Run("Reject empty HMAC verification key", func(t *testing.T) {
+		mgr, err := jwtutil.NewJWTManager(jwtutil.HS256, secretB,
+			jwtutil.WithHMACVerificationKeys(map[string][]byte{"a": nil}),
+		)
+		require.Error(t, err)
+		require.Nil(t, mgr)
+	})
+}
+
+func TestKeyResolvers(t *testing.T) {
+	tenantKeys := map[string][]byte{
+		"tenant-1": []byte("tenant-1-secret-at-least-32-bytes-long"),
+		"tenant-2": []byte("tenant-2-secret-at-least-32-bytes-long"),
+	}
+	errUnknownTenant := errors.New("unknown tenant")
+	resolveTenantKey := func(claims jwt.Claims) (interface{}, error) {
+		tenantClaims, ok := claims.(*TenantClaims)
+		if !ok {
+			return nil, errors.New("unexpected claims type")
+		}
+		key, ok := tenantKeys[tenantClaims.TenantID]
+		if !ok {
+			return nil, errUnknownTenant
+		}
+		return key, nil
+	}
+
+	manager, err := jwtutil.NewJWTManager(jwtutil.HS256, nil,
+		jwtutil.WithSigningKeyResolver(func(ctx context.Context, claims jwt.Claims) (interface{}, error) {
+			return resolveTenantKey(claims)
+		}),
+		jwtutil.WithVerificationKeyResolver(func(ctx context.Context, header map[string]interface{}, claims jwt.Claims) (interface{}, error) {
+			require.Equal(t, "HS256", header["alg"])
+			return resolveTenantKey(claims)
+		}),
+	)
+	require.NoError(t, err)
+
+	registered := jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(5 * time.Minute))}
+
+	t.Run("Tokens are signed and verified with the tenant's key", func(t *testing.T) {
+		for tenantID, key := range tenantKeys {
+			tokenStr, err := manager.CreateToken(context.Background(), &TenantClaims{RegisteredClaims: registered, TenantID: tenantID})
+			require.NoError(t, err)
+
+			// The token is verifiable with the tenant's key only.
+			tenantManager, err := jwtutil.NewJWTManager(jwtutil.HS256, key)
+			require.NoError(t, err)
+			require.NoError(t, tenantManager.ParseAndValidateToken(context.Background(), tokenStr, &TenantClaims{}))
+
+			parsedClaims := &TenantClaims{}
+			require.NoError(t, manager.ParseAndValidateToken(context.Background(), tokenStr, parsedClaims))
+			require.Equal(t, tenantID, parsedClaims.TenantID)
+		}
+	})
+
+	t.Run("Token signed with another tenant's key", func(t *testing.T) {
+		// Claims tenant-1 but is signed with tenant-2's key.
+		forger, err := jwtutil.NewJWTManager(jwtutil.HS256, tenantKeys["tenant-2"])
+		require.NoError(t, err)
+		tokenStr, err := forger.CreateToken(context.Background(), &TenantClaims{RegisteredClaims: registered, TenantID: "tenant-1"})
+		require.NoError(t, err)
+
+		err = manager.ParseAndValidateToken(context.Background(), tokenStr, &TenantClaims{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrTokenSignatureInvalid))
+	})
+
+	t.Run("Verification key resolver error", func(t *testing.T) {
+		forger, err := jwtutil.NewJWTManager(jwtutil.HS256, tenantKeys["tenant-1"])
+		require.NoError(t, err)
+		tokenStr, err := forger.CreateToken(context.Background(), &TenantClaims{RegisteredClaims: registered, TenantID: "tenant-3"})
+		require.NoError(t, err)
+
+		err = manager.ParseAndValidateToken(context.Background(), tokenStr, &TenantClaims{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrTokenSignatureInvalid))
+		require.True(t, errors.Is(err, errUnknownTenant))
+	})
+
+	t.Run("Signing key resolver error", func(t *testing.T) {
+		_, err := manager.CreateToken(context.Background(), &TenantClaims{RegisteredClaims: registered, TenantID: "tenant-3"})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, errUnknownTenant))
+	})
+
+	t.Run("Signing key is required without both resolvers", func(t *testing.T) {
+		_, err := jwtutil.NewJWTManager(jwtutil.HS256, nil,
+			jwtutil.WithSigningKeyResolver(func(ctx context.Context, claims jwt.Claims) (interface{}, error) {
+				return resolveTenantKey(claims)
+			}),
+		)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "missing signing key")
+	})
+}
+
+func TestHMACKeyStrength(t *testing.T) {
+	weakKey := []byte("0123456789abcdef")                   // 16 bytes
+	strongKey := []byte("0123456789abcdef0123456789abcdef") // 32 bytes
+
+	t.Run("Reject 16-byte HS256 key by default", func(t *testing.T) {
+		mgr, err := jwtutil.NewJWTManager(jwtutil.HS256, weakKey)
+		require.Error(t, err)
+		require.Nil(t, mgr)
+		require.True(t, errors.Is(err, jwtutil.ErrWeakKey))
+		require.Contains(t, err.Error(), "HS256 requires at least 32 bytes, got 16")
+	})
+
+	t.Run("Accept 16-byte HS256 key with WithAllowWeakKey", func(t *testing.T) {
+		mgr, err := jwtutil.NewJWTManager(jwtutil.HS256, weakKey, jwtutil.WithAllowWeakKey())
+		require.NoError(t, err)
+
+		token, err := mgr.CreateToken(context.Background(), &jwt.RegisteredClaims{Subject: "user"})
+		require.NoError(t, err)
+		require.NoError(t, mgr.ParseAndValidateToken(context.Background(), token, &jwt.RegisteredClaims{}))
+	})
+
+	t.Run("Accept 32-byte HS256 key", func(t *testing.T) {
+		mgr, err := jwtutil.NewJWTManager(jwtutil.HS256, strongKey)
+		require.NoError(t, err)
+		require.NotNil(t, mgr)
+	})
+
+	t.Run("Reject weak HMAC verification key", func(t *testing.T) {
+		mgr, err := jwtutil.NewJWTManager(jwtutil.HS256, strongKey,
+			jwtutil.WithHMACVerificationKeys(map[string][]byte{"old": weakKey}),
+		)
+		require.Error(t, err)
+		require.Nil(t, mgr)
+		require.True(t, errors.Is(err, jwtutil.ErrWeakKey))
+		require.Contains(t, err.Error(), `verification key "old"`)
+	})
+}
@@ -0,0 +1,159 @@
+package jwt
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/kittipat1413/go-common/framework/cache"
+	"github.com/kittipat1413/go-common/framework/cache/localcache"
+)
+
+//go:generate mockgen -source=./reference.go -destination=./mocks/reference.go -package=jwt_mocks
+
+// referenceTokenBytes is the number of random bytes in a reference token (256 bits).
+const referenceTokenBytes = 32
+
+// ErrReferenceTokenNotFound is returned (wrapped) by ReferenceTokenManager.ParseAndValidateToken when the token
+// is unknown, for example because it has been revoked or removed from the store after expiring.
+var ErrReferenceTokenNotFound = errors.New("reference token not found")
+
+// ReferenceTokenManager issues and validates opaque reference tokens: short random strings whose claims are kept
+// in a TokenStore instead of being encoded in the token, for clients that cannot handle large JWTs.
+type ReferenceTokenManager interface {
+	// CreateToken generates a reference token and stores the provided claims under it until their `exp` claim.
+	// The claims should implement the jwt.Claims interface (e.g., *jwt.RegisteredClaims or a custom struct)
+	// and must be JSON-serializable.
+	CreateToken(ctx context.Context, claims jwt.Claims) (string, error)
+
+	// ParseAndValidateToken looks up the token and populates the provided claims struct with the stored claims,
+	// then validates their time-based claims (`exp`, `nbf`, `iat`).
+	// The user must pass a pointer to a claims struct of the type used in CreateToken.
+	ParseAndValidateToken(ctx context.Context, tokenString string, claims jwt.Claims) error
+
+	// RevokeToken removes the token from the store, so that it is no longer valid.
+	RevokeToken(ctx context.Context, tokenString string) error
+}
+
+// TokenStore stores the serialized claims of reference tokens.
+// Implement it to share tokens between instances (e.g., backed by Redis).
+type TokenStore interface {
+	// Save stores the claims under the token ID for the given TTL.
+	Save(ctx context.Context, id string, claims []byte, ttl time.Duration) error
+	// Load returns the claims stored under the token ID, and whether they were found.
+	Load(ctx context.Context, id string) ([]byte, bool, error)
+	// Delete removes the claims stored under the token ID. Deleting an unknown ID is not an error.
+	Delete(ctx context.Context, id string) error
+}
+
+// referenceTokenManager is the implementation of the ReferenceTokenManager interface.
+type referenceTokenManager struct {
+	store TokenStore
+}
+
+// NewReferenceTokenManager initializes a new reference token manager backed by the given store.
+func NewReferenceTokenManager(store TokenStore) (ReferenceTokenManager, error) {
+	if store == nil {
+		return nil, errors.New("failed to create reference token manager: missing token store")
+	}
+	return &referenceTokenManager{store: store}, nil
+}
+
+// CreateToken generates a random reference token and stores the claims under it, with a TTL matching the `exp` claim.
+// The claims must have an `exp` claim in the future; otherwise an error wrapping ErrMissingExpiration or ErrTokenExpired is returned.
+func (m *referenceTokenManager) CreateToken(ctx context.Context, claims jwt.Claims) (string, error) {
+	expiresAt, err := claims.GetExpirationTime()
+	if err != nil {
+		return "", fmt.Errorf("failed to read token expiry: %w", err)
+	}
+	if expiresAt == nil {
+		return "", fmt.Errorf("failed to read token expiry: %w", ErrMissingExpiration)
+	}
+	ttl := time.Until(expiresAt.Time)
+	if ttl <= 0 {
+		return "", fmt.Errorf("failed to create token: %w", ErrTokenExpired)
+	}
+
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode claims: %w", err)
+	}
+
+	id := make([]byte, referenceTokenBytes)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	tokenString := base64.RawURLEncoding.EncodeToString(id)
+
+	if err := m.store.Save(ctx, tokenString, data, ttl); err != nil {
+		return "", fmt.Errorf("failed to store token: %w", err)
+	}
+	return tokenString, nil
+}
+
+// ParseAndValidateToken looks up the token and populates the provided claims struct with the stored claims.
+// If the token is unknown, an error wrapping ErrReferenceTokenNotFound is returned; if its claims are expired or
+// not valid yet, an error wrapping ErrTokenExpired or ErrTokenNotValidYet is returned.
+func (m *referenceTokenManager) ParseAndValidateToken(ctx context.Context, tokenString string, claims jwt.Claims) error {
+	data, found, err := m.store.Load(ctx, tokenString)
+	if err != nil {
+		return fmt.Errorf("failed to load token: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("invalid token: %w", ErrReferenceTokenNotFound)
+	}
+
+	if err := json.Unmarshal(data, claims); err != nil {
+		return fmt.Errorf("failed to decode claims: %w", err)
+	}
+
+	// The store may keep entries slightly past their TTL, so the time-based claims are validated as well.
+	if err := jwt.NewValidator(jwt.WithExpirationRequired()).Validate(claims); err != nil {
+		return fmt.Errorf("invalid token: %w", mapParseError(err))
+	}
+	return nil
+}
+
+// RevokeToken removes the token from the store.
+func (m *referenceTokenManager) RevokeToken(ctx context.Context, tokenString string) error {
+	if err := m.store.Delete(ctx, tokenString); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+// inMemoryTokenStore is a TokenStore backed by a localcache.
+type inMemoryTokenStore struct {
+	cache cache.Cache[[]byte]
+}
+
+// NewInMemoryTokenStore returns a TokenStore that keeps tokens in process memory.
+// Expired tokens are removed periodically (see localcache.WithCleanupInterval).
+func NewInMemoryTokenStore(opts ...localcache.Option) TokenStore {
+	return &inMemoryTokenStore{cache: localcache.New[[]byte](opts...)}
+}
+
+func (s *inMemoryTokenStore) Save(ctx context.Context, id string, claims []byte, ttl time.Duration) error {
+	s.cache.Set(ctx, id, claims, &ttl)
+	return nil
+}
+
+func (s *inMemoryTokenStore) Load(ctx context.Context, id string) ([]byte, bool, error) {
+	claims, err := s.cache.Get(ctx, id, nil)
+	if errors.Is(err, cache.ErrCacheMiss) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return claims, true, nil
+}
+
+func (s *inMemoryTokenStore) Delete(ctx context.Context, id string) error {
+	return s.cache.Invalidate(ctx, id)
+}
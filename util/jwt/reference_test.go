@@ -0,0 +1,145 @@
+package jwt_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	jwtutil "github.com/kittipat1413/go-common/util/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+type sessionClaims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+}
+
+// noTTLTokenStore is a TokenStore that ignores the TTL, to exercise claim expiry validation.
+type noTTLTokenStore struct {
+	mu     sync.Mutex
+	tokens map[string][]byte
+}
+
+func (s *noTTLTokenStore) Save(ctx context.Context, id string, claims []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[id] = claims
+	return nil
+}
+
+func (s *noTTLTokenStore) Load(ctx context.Context, id string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	claims, ok := s.tokens[id]
+	return claims, ok, nil
+}
+
+func (s *noTTLTokenStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tokens, id)
+	return nil
+}
+
+func TestNewReferenceTokenManager(t *testing.T) {
+	_, err := jwtutil.NewReferenceTokenManager(nil)
+	require.Error(t, err)
+}
+
+func TestReferenceTokenManager(t *testing.T) {
+	ctx := context.Background()
+	manager, err := jwtutil.NewReferenceTokenManager(jwtutil.NewInMemoryTokenStore())
+	require.NoError(t, err)
+
+	t.Run("Issue and validate", func(t *testing.T) {
+		tokenStr, err := manager.CreateToken(ctx, &sessionClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   "user-123",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(10 * time.Minute)),
+			},
+			Roles: []string{"admin"},
+		})
+		require.NoError(t, err)
+		require.Len(t, tokenStr, 43)
+
+		claims := &sessionClaims{}
+		require.NoError(t, manager.ParseAndValidateToken(ctx, tokenStr, claims))
+		require.Equal(t, "user-123", claims.Subject)
+		require.Equal(t, []string{"admin"}, claims.Roles)
+
+		otherTokenStr, err := manager.CreateToken(ctx, &sessionClaims{
+			RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))},
+		})
+		require.NoError(t, err)
+		require.NotEqual(t, tokenStr, otherTokenStr)
+	})
+
+	t.Run("Expired token is removed from the store", func(t *testing.T) {
+		tokenStr, err := manager.CreateToken(ctx, &jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(1100 * time.Millisecond)),
+		})
+		require.NoError(t, err)
+		require.NoError(t, manager.ParseAndValidateToken(ctx, tokenStr, &jwt.RegisteredClaims{}))
+
+		// NumericDate has second precision, so the TTL may be up to one second shorter than requested.
+		time.Sleep(1200 * time.Millisecond)
+		err = manager.ParseAndValidateToken(ctx, tokenStr, &jwt.RegisteredClaims{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrReferenceTokenNotFound))
+	})
+
+	t.Run("Revoke", func(t *testing.T) {
+		tokenStr, err := manager.CreateToken(ctx, &jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(10 * time.Minute)),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, manager.RevokeToken(ctx, tokenStr))
+		err = manager.ParseAndValidateToken(ctx, tokenStr, &jwt.RegisteredClaims{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrReferenceTokenNotFound))
+
+		// Revoking an unknown token is not an error.
+		require.NoError(t, manager.RevokeToken(ctx, "unknown"))
+	})
+
+	t.Run("Unknown token", func(t *testing.T) {
+		err := manager.ParseAndValidateToken(ctx, "unknown", &jwt.RegisteredClaims{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrReferenceTokenNotFound))
+	})
+
+	t.Run("Claims without exp", func(t *testing.T) {
+		_, err := manager.CreateToken(ctx, &jwt.RegisteredClaims{Subject: "user-123"})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrMissingExpiration))
+	})
+
+	t.Run("Claims already expired", func(t *testing.T) {
+		_, err := manager.CreateToken(ctx, &jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+		})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, jwtutil.ErrTokenExpired))
+	})
+}
+
+func TestReferenceTokenManager_ValidatesStoredClaims(t *testing.T) {
+	ctx := context.Background()
+	manager, err := jwtutil.NewReferenceTokenManager(&noTTLTokenStore{tokens: make(map[string][]byte)})
+	require.NoError(t, err)
+
+	tokenStr, err := manager.CreateToken(ctx, &jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(1100 * time.Millisecond)),
+	})
+	require.NoError(t, err)
+
+	// The store keeps the token past its TTL, so the expiry is detected from the claims.
+	time.Sleep(1200 * time.Millisecond)
+	err = manager.ParseAndValidateToken(ctx, tokenStr, &jwt.RegisteredClaims{})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, jwtutil.ErrTokenExpired))
+}